@@ -0,0 +1,167 @@
+package proio
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+
+	protobuf "github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	proto "github.com/proio-org/go-proio-pb"
+)
+
+// OpenAppend opens the given file for appending events to it, creating the
+// file if it does not exist, and returns a Writer for the file.  The bucket
+// headers already in the file are read in order to restore the state of the
+// Writer that wrote them, so that metadata and FileDescriptorProtos that are
+// already in the file are not repeated.  The Writer continues with the
+// compression of the last bucket, and WriteIndex and WriteChecksums are set if
+// the file has an index footer or the last bucket has a checksum,
+// respectively.  An index footer or a single bucket that was only partially
+// written at the end of the file is removed from the file.  If anything else
+// follows the last complete bucket, or a non-empty file has no complete
+// buckets, an error is returned and the file is left unchanged.
+func OpenAppend(filename string) (*Writer, error) {
+	file, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE, 0666)
+	if err != nil {
+		return nil, err
+	}
+
+	writer, err := appendWriter(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	writer.DeferUntilClose(file.Close)
+
+	return writer, nil
+}
+
+// appendWriter restores the state of the Writer that wrote a file, and
+// returns a new Writer positioned at the end of the last complete bucket.
+func appendWriter(file *os.File) (*Writer, error) {
+	reader := NewReader(file)
+	index, err := reader.buildIndex()
+	if err != nil {
+		return nil, err
+	}
+	footer, err := reader.readIndexFooter()
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := make(map[string][]byte)
+	writtenFDs := make(map[protobuf.Message]bool)
+	var lastHeader *proto.BucketHeader
+	end := int64(0)
+	for i, bucket := range index.Buckets {
+		last := i == len(index.Buckets)-1
+		if !bucket.Metadata && !bucket.FileDescriptors && !last {
+			continue
+		}
+
+		if err := reader.seekStream(bucket.Offset); err != nil {
+			return nil, err
+		}
+		header, _, _, err := reader.readBareHeader()
+		if err != nil {
+			return nil, err
+		}
+
+		for key, value := range header.Metadata {
+			metadata[key] = value
+		}
+		for _, fdBytes := range header.FileDescriptor {
+			fdProto := &descriptor.FileDescriptorProto{}
+			if err := protobuf.Unmarshal(fdBytes, fdProto); err != nil {
+				continue
+			}
+			addFDFromBytes(fdBytes)
+			if storedFD, ok := fdProtoStore.Load(fdProto.GetName()); ok {
+				writtenFDs[storedFD.(protobuf.Message)] = true
+			}
+		}
+
+		if last {
+			lastHeader = header
+			end = reader.streamOffset + int64(header.BucketSize)
+		}
+	}
+
+	size, err := file.Seek(0, 2 /*io.SeekEnd*/)
+	if err != nil {
+		return nil, err
+	}
+	if size > 0 && len(index.Buckets) == 0 {
+		return nil, errors.New("no complete buckets in file")
+	}
+
+	// remove an index footer or a partial bucket after the last complete
+	// bucket, but nothing else
+	if size > end {
+		footerFollows := footer != nil && len(footer.Buckets) == len(index.Buckets) &&
+			footer.Buckets[len(footer.Buckets)-1].Offset == index.Buckets[len(index.Buckets)-1].Offset
+		if !footerFollows {
+			partial, err := isPartialBucket(io.NewSectionReader(file, end, size-end))
+			if err != nil {
+				return nil, err
+			}
+			if !partial {
+				return nil, errors.New("unexpected data after last complete bucket")
+			}
+		}
+		if err := file.Truncate(end); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := file.Seek(end, 0 /*io.SeekStart*/); err != nil {
+		return nil, err
+	}
+
+	writer := NewWriter(file)
+	writer.index = *index
+	writer.metadata = metadata
+	writer.writtenFDs = writtenFDs
+	writer.WriteIndex = footer != nil
+	if lastHeader != nil {
+		writer.bucketHeader.Compression = lastHeader.Compression
+		writer.WriteChecksums = lastHeader.GetChecksum() != 0
+	}
+
+	return writer, nil
+}
+
+// isPartialBucket returns whether the given bytes from the end of a file are
+// the beginning of a single bucket, as is left behind by an interrupted
+// write.  That is, they must start with the magic number, or with as much of
+// it as there is, and must not contain the magic number of another bucket.
+func isPartialBucket(tail io.Reader) (bool, error) {
+	buf := make([]byte, 1<<16)
+	n, err := io.ReadFull(tail, buf[:len(magicBytes)])
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return bytes.Equal(buf[:n], magicBytes[:n]), nil
+	} else if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(buf[:n], magicBytes[:]) {
+		return false, nil
+	}
+
+	// keep enough bytes from the previous read to find a magic number that
+	// spans two reads
+	nKept := copy(buf, buf[1:n])
+	for {
+		n, err := tail.Read(buf[nKept:])
+		n += nKept
+		if bytes.Contains(buf[:n], magicBytes[:]) {
+			return false, nil
+		}
+		if err == io.EOF {
+			return true, nil
+		} else if err != nil {
+			return false, err
+		}
+		nKept = copy(buf, buf[n-(len(magicBytes)-1):n])
+	}
+}
@@ -0,0 +1,87 @@
+package proio
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// CreateAtomic is like Create, except that the Writer writes to a temporary
+// file in the same directory as filename, and the file only appears at
+// filename once Close has flushed the Writer and synced the file to storage
+// without errors.  An existing file at filename is replaced at that point.
+// If writing to the temporary file fails at any point, or Close fails, the
+// temporary file is removed and filename is left untouched.  The temporary
+// file is hidden, and its name does not end with the extension of filename,
+// so a process that is killed while writing does not leave behind a file that
+// looks complete.
+func CreateAtomic(filename string) (*Writer, error) {
+	dir, base := filepath.Split(filename)
+	prefix := filepath.Join(dir, "."+base+".tmp"+strconv.Itoa(os.Getpid())+"-")
+
+	var file *os.File
+	var err error
+	for i := 0; i < 1000; i++ {
+		file, err = os.OpenFile(prefix+strconv.Itoa(i), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0666)
+		if !os.IsExist(err) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	output := &atomicOutput{file: file, filename: filename}
+	writer := NewWriter(output)
+	writer.atomic = output
+
+	return writer, nil
+}
+
+// atomicOutput is the stream of a Writer created with CreateAtomic.  It
+// remembers whether any write to the temporary file failed.
+type atomicOutput struct {
+	file     *os.File
+	filename string
+	err      error
+}
+
+func (output *atomicOutput) Write(buf []byte) (int, error) {
+	n, err := output.file.Write(buf)
+	if err != nil && output.err == nil {
+		output.err = err
+	}
+	return n, err
+}
+
+// commit syncs and closes the temporary file, and renames it to the final
+// filename.  The temporary file is removed if any of this fails.
+func (output *atomicOutput) commit() error {
+	err := output.err
+	if err == nil {
+		err = output.file.Sync()
+	}
+	if closeErr := output.file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(output.file.Name(), output.filename)
+	}
+	if err != nil {
+		os.Remove(output.file.Name())
+		return err
+	}
+
+	// make the rename itself durable, where directories can be synced
+	if dir, err := os.Open(filepath.Dir(output.filename)); err == nil {
+		dir.Sync()
+		dir.Close()
+	}
+	return nil
+}
+
+// discard closes and removes the temporary file.
+func (output *atomicOutput) discard() {
+	output.file.Close()
+	os.Remove(output.file.Name())
+}
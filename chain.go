@@ -0,0 +1,134 @@
+package proio
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// OpenFiles opens the given existing files (in read-only mode) and returns a
+// Reader that presents them as one continuous stream, in the order given.
+// Only one file is kept open at a time, and each of the remaining files is
+// opened once the Reader reaches the end of the previous one.  Metadata carry
+// over from one file to the next, as within a single stream, so that a file
+// only needs to hold the metadata that it adds or overwrites.  Index,
+// EventCount, SeekToEvent, SeekToStart and BucketHeaderAt act on the file that
+// is currently being read.
+func OpenFiles(filenames ...string) (*Reader, error) {
+	if len(filenames) == 0 {
+		return nil, errors.New("no files to open")
+	}
+	for _, filename := range filenames[1:] {
+		if _, err := os.Stat(filename); err != nil {
+			return nil, err
+		}
+	}
+
+	file, err := os.Open(filenames[0])
+	if err != nil {
+		return nil, err
+	}
+
+	rdr := NewReader(file)
+	rdr.sourceName = filenames[0]
+	rdr.closeSource = func() { file.Close() }
+	for _, filename := range filenames[1:] {
+		rdr.sources = append(rdr.sources, chainSource{name: filename})
+	}
+	return rdr, nil
+}
+
+// OpenGlob is like OpenFiles, except that the files are those matching the
+// given pattern (see filepath.Match), in lexical order.
+func OpenGlob(pattern string) (*Reader, error) {
+	filenames, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if len(filenames) == 0 {
+		return nil, errors.New("no files match " + pattern)
+	}
+	return OpenFiles(filenames...)
+}
+
+// NewChainReader is like OpenFiles, except that it wraps existing io.Readers.
+// As with NewReader, the io.Readers are not closed by the Reader.
+func NewChainReader(streamReaders ...io.Reader) *Reader {
+	if len(streamReaders) == 0 {
+		return NewReader(bytes.NewReader(nil))
+	}
+
+	rdr := NewReader(streamReaders[0])
+	rdr.sourceName = readerName(streamReaders[0])
+	for _, streamReader := range streamReaders[1:] {
+		rdr.sources = append(rdr.sources, chainSource{
+			name:   readerName(streamReader),
+			reader: streamReader,
+		})
+	}
+	return rdr
+}
+
+// Source returns the name of the source that the Reader is currently reading
+// from.  This is the filename for Readers created with Open, OpenFiles or
+// OpenGlob, and the result of a Name method (as for *os.File) for io.Readers
+// that have one.  Otherwise, it is an empty string.
+func (rdr *Reader) Source() string {
+	return rdr.sourceName
+}
+
+// BucketOffset returns the position of the current bucket's magic number
+// within the current source.
+func (rdr *Reader) BucketOffset() int64 {
+	return rdr.bucketOffset
+}
+
+type chainSource struct {
+	name   string
+	reader io.Reader
+}
+
+// nextSource switches the Reader over to the next source in the chain,
+// opening it if necessary.
+func (rdr *Reader) nextSource() error {
+	rdr.stopPrefetch(false)
+	if rdr.closeSource != nil {
+		rdr.closeSource()
+		rdr.closeSource = nil
+	}
+
+	source := rdr.sources[0]
+	rdr.sources = rdr.sources[1:]
+
+	streamReader := source.reader
+	if streamReader == nil {
+		file, err := os.Open(source.name)
+		if err != nil {
+			return err
+		}
+		streamReader = file
+		rdr.closeSource = func() { file.Close() }
+	}
+
+	rdr.streamReader = streamReader
+	rdr.streamOffset = 0
+	if seeker, ok := streamReader.(io.Seeker); ok {
+		rdr.streamOffset, _ = seeker.Seek(0, 1 /*io.SeekCurrent*/)
+	}
+	rdr.sourceName = source.name
+	rdr.index = nil
+	return nil
+}
+
+type namer interface {
+	Name() string
+}
+
+func readerName(streamReader io.Reader) string {
+	if named, ok := streamReader.(namer); ok {
+		return named.Name()
+	}
+	return ""
+}
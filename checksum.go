@@ -0,0 +1,52 @@
+package proio
+
+import (
+	"hash/crc32"
+	"strconv"
+
+	proto "github.com/proio-org/go-proio-pb"
+)
+
+// ChecksumError is returned by the Reader when the bytes of a bucket do not
+// match the CRC32C checksum recorded in its header.  The events of the bucket
+// are skipped, so that reading continues with the following bucket.  Offset
+// is the position of the bucket's magic number in the stream, and NEvents is
+// the number of events lost.
+type ChecksumError struct {
+	Offset   int64
+	NEvents  uint64
+	Expected uint32
+	Actual   uint32
+}
+
+func (err *ChecksumError) Error() string {
+	return "bucket checksum mismatch at offset " + strconv.FormatInt(err.Offset, 10)
+}
+
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// setBucketChecksum records the checksum of the bucket bytes in the header,
+// replacing any previous checksum.
+func setBucketChecksum(header *proto.BucketHeader, bucketBytes []byte) {
+	header.Checksum = crc32.Checksum(bucketBytes, crc32cTable)
+}
+
+// verifyBucketChecksum checks the bucket bytes against the checksum recorded
+// in the header, if any.
+func verifyBucketChecksum(header *proto.BucketHeader, bucketBytes []byte, offset int64) error {
+	expected := header.GetChecksum()
+	if expected == 0 {
+		return nil
+	}
+
+	actual := crc32.Checksum(bucketBytes, crc32cTable)
+	if actual != expected {
+		return &ChecksumError{
+			Offset:   offset,
+			NEvents:  header.NEvents,
+			Expected: expected,
+			Actual:   actual,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package proio
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	protobuf "github.com/golang/protobuf/proto"
+	proto "github.com/proio-org/go-proio-pb"
+)
+
+// DanglingPolicy determines what is done with references to entries that are
+// not in the Event when entry IDs are rewritten.
+type DanglingPolicy int
+
+const (
+	// NullDangling sets dangling references in singular fields to 0, and
+	// removes them from repeated fields.
+	NullDangling DanglingPolicy = iota
+	// FailOnDangling makes the operation fail, leaving the Event unchanged.
+	FailOnDangling
+)
+
+// Compact renumbers the entries of the Event densely from 1, in the order of
+// their current IDs, so that NEntries is the number of entries.  All
+// registered reference fields (see RegisterReferenceField) and tags are
+// rewritten to use the new IDs, and dangling references, such as those to
+// entries that were removed, are handled according to policy.  The dangling
+// references that were found are returned, with the IDs from before
+// compaction.  Entries previously returned by GetEntry should not be used after
+// Compact, since they are not updated.
+func (evt *Event) Compact(policy DanglingPolicy) ([]Reference, error) {
+	evt.FlushCache()
+
+	ids := sortEntries(evt.AllEntries())
+	newIDs := make(map[uint64]uint64)
+	for i, id := range ids {
+		newIDs[id] = uint64(i + 1)
+	}
+
+	var dangling []Reference
+	payloads := make(map[uint64][]byte)
+	for _, id := range ids {
+		payload, entryDangling, err := evt.remapReferences(id, newIDs)
+		if err != nil {
+			return nil, err
+		}
+		if payload != nil {
+			payloads[id] = payload
+		}
+		dangling = append(dangling, entryDangling...)
+	}
+
+	if len(dangling) > 0 && policy == FailOnDangling {
+		return dangling, errors.New(strconv.Itoa(len(dangling)) + " dangling references")
+	}
+
+	entries := make(map[uint64]*proto.Any)
+	for id, entryProto := range evt.proto.Entry {
+		if payload, ok := payloads[id]; ok {
+			entryProto.Payload = payload
+		}
+		entries[newIDs[id]] = entryProto
+	}
+	evt.proto.Entry = entries
+	evt.proto.NEntries = uint64(len(entries))
+
+	// tags of entries that do not exist are dropped
+	for _, tagProto := range evt.proto.Tag {
+		tagged := tagProto.Entry[:0]
+		for _, id := range tagProto.Entry {
+			if newID, ok := newIDs[id]; ok {
+				tagged = append(tagged, newID)
+			}
+		}
+		tagProto.Entry = tagged
+	}
+
+	evt.entryCache = make(map[uint64]protobuf.Message)
+
+	return dangling, nil
+}
+
+// remapReferences rewrites the registered reference fields of a copy of an
+// entry, using newIDs to look up the new ID for each referenced ID.
+// References to IDs that are not in newIDs are nulled, and returned as
+// dangling.  The payload of the rewritten entry is returned, or nil if it is
+// unchanged.  The entry cache should be flushed beforehand.
+func (evt *Event) remapReferences(id uint64, newIDs map[uint64]uint64) ([]byte, []Reference, error) {
+	entryProto := evt.proto.Entry[id]
+	typeName := evt.proto.Type[entryProto.Type]
+	fields := registeredReferenceFields(typeName)
+	if len(fields) == 0 {
+		return nil, nil, nil
+	}
+
+	entry := newDynamicMessage(typeName)
+	if entry == nil {
+		return nil, nil, &UnknownTypeError{Type: typeName}
+	}
+	if err := entry.Unmarshal(entryProto.Payload); err != nil {
+		return nil, nil, errors.New(
+			"failure to unmarshal entry " +
+				strconv.FormatUint(id, 10) +
+				" with type " +
+				typeName,
+		)
+	}
+
+	var dangling []Reference
+	changed := false
+	for _, field := range fields {
+		err := mapReferenceField(entry, strings.Split(field.path, "."), func(to uint64) uint64 {
+			newTo, ok := newIDs[to]
+			if !ok {
+				dangling = append(dangling, Reference{id, to, field.path, field.kind})
+			}
+			if newTo != to {
+				changed = true
+			}
+			return newTo
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if !changed {
+		return nil, dangling, nil
+	}
+	payload, err := entry.Marshal()
+	return payload, dangling, err
+}
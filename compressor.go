@@ -0,0 +1,140 @@
+package proio
+
+import (
+	"sync"
+
+	proto "github.com/proio-org/go-proio-pb"
+)
+
+// SetConcurrency sets the number of worker goroutines that compress buckets
+// for the Writer.  When nWorkers is greater than zero, filled buckets are
+// handed to the workers instead of being compressed inside Push, and the
+// compressed buckets are written to the stream in order by a separate
+// goroutine.  Flush and Close wait for all outstanding buckets to be written,
+// and errors from the pipeline are returned by subsequent calls to Push,
+// Flush or Close.  A value of zero (the default) compresses and writes
+// buckets synchronously.  Concurrency does not speed up LZMA compression,
+// since the LZMA encoder keeps package-level state, and LZMA buckets are
+// therefore compressed one at a time regardless.  Only the time that Push
+// spends compressing is saved for them.
+func (wrt *Writer) SetConcurrency(nWorkers int) error {
+	if nWorkers < 0 {
+		nWorkers = 0
+	}
+	wrt.bucketMutex.Lock()
+	defer wrt.bucketMutex.Unlock()
+	if wrt.compressor != nil && wrt.compressor.nWorkers == nWorkers {
+		return nil
+	}
+
+	if err := wrt.flush(); err != nil {
+		return err
+	}
+	wrt.stopCompressor()
+	if nWorkers > 0 {
+		wrt.compressor = newCompressor(wrt, nWorkers)
+	}
+	return nil
+}
+
+type compressJob struct {
+	header proto.BucketHeader
+	level  int
+	data   []byte
+	err    error
+	done   chan struct{}
+}
+
+type compressor struct {
+	nWorkers int
+	jobs     chan *compressJob
+	queue    chan *compressJob
+	pending  sync.WaitGroup
+	err      error
+	errMutex sync.Mutex
+}
+
+func newCompressor(wrt *Writer, nWorkers int) *compressor {
+	comp := &compressor{
+		nWorkers: nWorkers,
+		jobs:     make(chan *compressJob),
+		queue:    make(chan *compressJob, 2*nWorkers),
+	}
+
+	for i := 0; i < nWorkers; i++ {
+		go func() {
+			for job := range comp.jobs {
+				job.data, job.err = compressBucket(job.header.Compression, job.level, job.data)
+				close(job.done)
+			}
+		}()
+	}
+
+	// write compressed buckets to the stream in the order they were submitted
+	go func() {
+		for job := range comp.queue {
+			<-job.done
+			if comp.getErr() == nil {
+				err := job.err
+				if err == nil {
+					err = wrt.writeFrame(&job.header, job.data)
+				}
+				comp.setErr(err)
+			}
+			comp.pending.Done()
+		}
+	}()
+
+	return comp
+}
+
+// submit queues a bucket for compression and writing.
+func (comp *compressor) submit(header proto.BucketHeader, level int, data []byte) error {
+	if err := comp.getErr(); err != nil {
+		return err
+	}
+
+	job := &compressJob{
+		header: header,
+		level:  level,
+		data:   data,
+		done:   make(chan struct{}),
+	}
+	comp.pending.Add(1)
+	comp.queue <- job
+	comp.jobs <- job
+	return nil
+}
+
+// wait blocks until all submitted buckets have been written, and returns the
+// first error encountered by the pipeline.
+func (comp *compressor) wait() error {
+	comp.pending.Wait()
+	return comp.getErr()
+}
+
+func (comp *compressor) getErr() error {
+	comp.errMutex.Lock()
+	defer comp.errMutex.Unlock()
+	return comp.err
+}
+
+func (comp *compressor) setErr(err error) {
+	comp.errMutex.Lock()
+	defer comp.errMutex.Unlock()
+	if comp.err == nil {
+		comp.err = err
+	}
+}
+
+// stopCompressor waits for outstanding buckets and shuts down the
+// compression pipeline.
+func (wrt *Writer) stopCompressor() {
+	if wrt.compressor == nil {
+		return
+	}
+	wrt.compressor.pending.Wait()
+	close(wrt.compressor.jobs)
+	close(wrt.compressor.queue)
+	wrt.compressor = nil
+}
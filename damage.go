@@ -0,0 +1,164 @@
+package proio
+
+import (
+	"errors"
+	"io"
+	"strconv"
+)
+
+// ErrResynchronized is the cause of a damaged region consisting of bytes that
+// do not belong to any bucket, which the Reader skipped in order to find the
+// next bucket.
+var ErrResynchronized = errors.New("stream resynchronized")
+
+// DamagePolicy determines how the Reader proceeds when it comes across a
+// damaged region of a stream, such as bytes that do not belong to any bucket,
+// a truncated bucket, or a bucket that fails its checksum or cannot be
+// decoded.
+type DamagePolicy int
+
+const (
+	// ReportDamage makes Next return nil once for each damaged region, with
+	// the Reader's Err member set to the cause of the damage.  The following
+	// call continues after the damaged region.  This is the default.
+	ReportDamage DamagePolicy = iota
+	// SkipDamage makes Next skip damaged regions without returning an error,
+	// so that only intact events are returned.  Damage is then only reported
+	// through the damage handler and counters.
+	SkipDamage
+	// StopAtDamage makes the Reader stop at the first damaged region, so that
+	// Next keeps returning nil with Err set to the cause of the damage until
+	// the Reader is sought to another position.
+	StopAtDamage
+)
+
+// DamageReport describes a damaged region of a stream that the Reader could
+// not read events from.  Offset is the position in the stream where the
+// region starts, and BytesSkipped is its size.  For a damaged bucket, the
+// region starts with the bucket's magic number and extends to the next intact
+// bucket, or to the end of the stream.  EventsLost is the
+// number of events that were expected in the region but not read, or -1 if
+// this cannot be estimated, as is the case for bytes that were skipped to
+// resynchronize the stream.  Err is the cause of the damage.
+type DamageReport struct {
+	Offset       int64
+	BytesSkipped int64
+	EventsLost   int64
+	Err          error
+}
+
+func (report *DamageReport) String() string {
+	str := "damaged region at offset " +
+		strconv.FormatInt(report.Offset, 10) +
+		": " +
+		strconv.FormatInt(report.BytesSkipped, 10) +
+		" bytes skipped"
+	if report.EventsLost >= 0 {
+		str += ", " + strconv.FormatInt(report.EventsLost, 10) + " events lost"
+	}
+	return str + " (" + report.Err.Error() + ")"
+}
+
+// DamageCounts accumulates the damaged regions that a Reader has come across.
+// EventsLost only counts regions for which the number of lost events could
+// be estimated.
+type DamageCounts struct {
+	Regions      int
+	BytesSkipped int64
+	EventsLost   uint64
+}
+
+// SetDamagePolicy sets how the Reader proceeds past damaged regions of the
+// stream.  See DamagePolicy.
+func (rdr *Reader) SetDamagePolicy(policy DamagePolicy) {
+	rdr.damagePolicy = policy
+}
+
+// SetDamageHandler sets a function that is called with a report of each
+// damaged region of the stream as the Reader comes across it, regardless of
+// the DamagePolicy.  The handler is called from whichever goroutine is
+// reading events, and it must not call methods of the Reader.
+func (rdr *Reader) SetDamageHandler(handler func(*DamageReport)) {
+	rdr.damageHandler = handler
+}
+
+// Damage returns counts of the damaged regions that the Reader has come
+// across so far.
+func (rdr *Reader) Damage() DamageCounts {
+	return rdr.damageCounts
+}
+
+// reportDamage records a damaged region and returns the error that the
+// current operation should return according to the DamagePolicy, which is
+// nil if the damage is to be skipped.
+func (rdr *Reader) reportDamage(report *DamageReport) error {
+	rdr.damageCounts.Regions++
+	rdr.damageCounts.BytesSkipped += report.BytesSkipped
+	if report.EventsLost > 0 {
+		rdr.damageCounts.EventsLost += uint64(report.EventsLost)
+	}
+	if rdr.damageHandler != nil {
+		rdr.damageHandler(report)
+	}
+
+	switch rdr.damagePolicy {
+	case SkipDamage:
+		return nil
+	case StopAtDamage:
+		rdr.stoppedErr = report.Err
+	}
+	return report.Err
+}
+
+// consumedOffset returns the stream offset up to which bytes have been
+// consumed for the current bucket, whether by the Reader itself or by the
+// prefetch pipeline.
+func (rdr *Reader) consumedOffset() int64 {
+	if rdr.prefetch != nil {
+		return rdr.resumeOffset
+	}
+	return rdr.streamOffset
+}
+
+// bucketDamaged marks the remainder of the current bucket as damaged.  The
+// damage is reported once the next bucket is found, so that the report covers
+// everything up to it.  Since the bucket size may be what is damaged, the
+// next bucket is looked for right after the bucket header, rather than after
+// the bytes that were taken to be the bucket.
+func (rdr *Reader) bucketDamaged(cause error) error {
+	if cause == io.EOF {
+		cause = io.ErrUnexpectedEOF
+	}
+	nLost := rdr.BucketHeader.NEvents - rdr.bucketIndex
+	rdr.bucketIndex = rdr.BucketHeader.NEvents
+	rdr.damagedBucket = &DamageReport{
+		Offset:     rdr.bucketOffset,
+		EventsLost: int64(nLost),
+		Err:        cause,
+	}
+
+	// the prefetch pipeline takes care of this for prefetched buckets
+	if rdr.prefetched != nil {
+		return nil
+	}
+	bucketBytes := make([]byte, rdr.streamOffset-rdr.bucketDataOffset)
+	rdr.bucket.ReadAt(bucketBytes, 0)
+	return rdr.rewindStream(rdr.bucketDataOffset, bucketBytes)
+}
+
+// regionDamaged reports the bytes from regionStart to regionEnd as damaged.
+// If there is a damaged bucket that has not been reported yet, the region
+// starts with that bucket instead, and is reported with its cause.
+func (rdr *Reader) regionDamaged(regionStart, regionEnd int64, cause error) error {
+	report := &DamageReport{
+		Offset:     regionStart,
+		EventsLost: -1,
+		Err:        cause,
+	}
+	if rdr.damagedBucket != nil {
+		report = rdr.damagedBucket
+		rdr.damagedBucket = nil
+	}
+	report.BytesSkipped = regionEnd - report.Offset
+	return rdr.reportDamage(report)
+}
@@ -0,0 +1,387 @@
+package proio
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	protobuf "github.com/golang/protobuf/proto"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/dynamic"
+)
+
+// DiffOptions modify the comparison of Events by DiffEvents.
+type DiffOptions struct {
+	// IgnoreIDs makes entries be matched up by their types and tags, rather
+	// than by their IDs.  Among the entries with the same type and tags, the
+	// entries are matched in the order of their IDs.  Reference fields (see
+	// RegisterReferenceField) are compared through the matching, so that
+	// Events that only differ in the numbering of their entries are equal.
+	IgnoreIDs bool
+	// FloatTolerance is the relative difference that is allowed between
+	// floating-point field values.
+	FloatTolerance float64
+	// IgnoreMetadata skips the comparison of Event metadata.
+	IgnoreMetadata bool
+}
+
+// Difference is a difference between two Events, found by DiffEvents.  Path
+// locates the difference, such as "metadata[run]", "tag[Truth]",
+// "entry[3].type" or "entry[3].vertex.x", and A and B are the respective
+// values, which are nil if absent.  If IDs are ignored, entry paths have the
+// form "entry[3/5]" where the IDs of the matched entries in the two Events
+// differ, and "entry[3/]" or "entry[/5]" for entries without a match.
+type Difference struct {
+	Path string
+	A, B interface{}
+}
+
+func (diff Difference) String() string {
+	format := func(value interface{}) string {
+		switch value := value.(type) {
+		case nil:
+			return "(none)"
+		case string:
+			return strconv.Quote(value)
+		case []byte:
+			return strconv.Quote(string(value))
+		case *dynamic.Message:
+			return "{" + value.String() + "}"
+		}
+		return fmt.Sprint(value)
+	}
+	return diff.Path + ": " + format(diff.A) + " != " + format(diff.B)
+}
+
+// DiffEvents compares two Events semantically, returning their differences
+// in metadata, tags, entry types and decoded entry field values.  Entries are
+// decoded with the FileDescriptorProtos known for their types (see
+// GetDynamicEntry), so that the comparison does not depend on the encoding of
+// the entries.  opts may be nil for the defaults.
+func DiffEvents(a *Event, b *Event, opts *DiffOptions) ([]Difference, error) {
+	if opts == nil {
+		opts = &DiffOptions{}
+	}
+	differ := &eventDiffer{a: a, b: b, opts: opts}
+
+	if !opts.IgnoreMetadata {
+		differ.diffMetadata()
+	}
+	differ.matchEntries()
+	differ.diffTags()
+	if err := differ.diffEntries(); err != nil {
+		return nil, err
+	}
+
+	return differ.diffs, nil
+}
+
+type eventDiffer struct {
+	a, b  *Event
+	opts  *DiffOptions
+	diffs []Difference
+
+	// matching of entry IDs from a to b, and from b to a
+	aToB, bToA map[uint64]uint64
+}
+
+func (differ *eventDiffer) add(path string, a, b interface{}) {
+	differ.diffs = append(differ.diffs, Difference{path, a, b})
+}
+
+func (differ *eventDiffer) diffMetadata() {
+	var keys []string
+	for key := range differ.a.Metadata {
+		keys = append(keys, key)
+	}
+	for key := range differ.b.Metadata {
+		if _, ok := differ.a.Metadata[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		aValue, aOk := differ.a.Metadata[key]
+		bValue, bOk := differ.b.Metadata[key]
+		if aOk != bOk || !bytes.Equal(aValue, bValue) {
+			var aDiff, bDiff interface{}
+			if aOk {
+				aDiff = aValue
+			}
+			if bOk {
+				bDiff = bValue
+			}
+			differ.add("metadata["+key+"]", aDiff, bDiff)
+		}
+	}
+}
+
+func (differ *eventDiffer) matchEntries() {
+	differ.aToB = make(map[uint64]uint64)
+	differ.bToA = make(map[uint64]uint64)
+
+	if !differ.opts.IgnoreIDs {
+		for id := range differ.a.proto.Entry {
+			if _, ok := differ.b.proto.Entry[id]; ok {
+				differ.aToB[id] = id
+				differ.bToA[id] = id
+			}
+		}
+		return
+	}
+
+	bGroups := entryGroups(differ.b)
+	for key, aIDs := range entryGroups(differ.a) {
+		bIDs := bGroups[key]
+		for i := 0; i < len(aIDs) && i < len(bIDs); i++ {
+			differ.aToB[aIDs[i]] = bIDs[i]
+			differ.bToA[bIDs[i]] = aIDs[i]
+		}
+	}
+}
+
+// entryGroups groups the IDs of the entries of an Event by their types and
+// tags, in increasing order.
+func entryGroups(event *Event) map[string][]uint64 {
+	entryTags := make(map[uint64][]string)
+	for _, tag := range event.Tags() {
+		for _, id := range event.TaggedEntries(tag) {
+			entryTags[id] = append(entryTags[id], tag)
+		}
+	}
+
+	groups := make(map[string][]uint64)
+	for _, id := range sortEntries(event.AllEntries()) {
+		typeName := event.proto.Type[event.proto.Entry[id].Type]
+		key := typeName + "\x00" + strings.Join(entryTags[id], "\x00")
+		groups[key] = append(groups[key], id)
+	}
+	return groups
+}
+
+func (differ *eventDiffer) entryPath(aID uint64, bID uint64) string {
+	if aID == bID {
+		return "entry[" + strconv.FormatUint(aID, 10) + "]"
+	}
+	return "entry[" + strconv.FormatUint(aID, 10) + "/" + strconv.FormatUint(bID, 10) + "]"
+}
+
+func (differ *eventDiffer) diffTags() {
+	tags := differ.a.Tags()
+	for _, tag := range differ.b.Tags() {
+		if _, ok := differ.a.proto.Tag[tag]; !ok {
+			tags = append(tags, tag)
+		}
+	}
+	sort.Strings(tags)
+
+	for _, tag := range tags {
+		// empty tags are treated as absent
+		aIDs := sortEntries(differ.a.TaggedEntries(tag))
+		bIDs := sortEntries(differ.b.TaggedEntries(tag))
+		aOk := len(aIDs) > 0
+		bOk := len(bIDs) > 0
+
+		// compare the tags through the matching of entries, in terms of the
+		// IDs of a where possible
+		inB := make(map[uint64]bool)
+		for _, id := range bIDs {
+			inB[id] = true
+		}
+		equal := len(aIDs) == len(bIDs)
+		for _, id := range aIDs {
+			bID, ok := differ.aToB[id]
+			if !ok || !inB[bID] {
+				equal = false
+			}
+		}
+		if equal {
+			continue
+		}
+
+		var aDiff, bDiff interface{}
+		if aOk {
+			aDiff = aIDs
+		}
+		if bOk {
+			bDiff = bIDs
+		}
+		differ.add("tag["+tag+"]", aDiff, bDiff)
+	}
+}
+
+func (differ *eventDiffer) diffEntries() error {
+	for _, aID := range sortEntries(differ.a.AllEntries()) {
+		bID, ok := differ.aToB[aID]
+		if !ok {
+			path := "entry[" + strconv.FormatUint(aID, 10) + "]"
+			if differ.opts.IgnoreIDs {
+				path = "entry[" + strconv.FormatUint(aID, 10) + "/]"
+			}
+			differ.add(path, differ.entryTypeName(differ.a, aID), nil)
+			continue
+		}
+		path := differ.entryPath(aID, bID)
+
+		aType := differ.entryTypeName(differ.a, aID)
+		bType := differ.entryTypeName(differ.b, bID)
+		if aType != bType {
+			differ.add(path+".type", aType, bType)
+			continue
+		}
+
+		aEntry := differ.a.GetDynamicEntry(aID)
+		if aEntry == nil {
+			return differ.a.Err
+		}
+		bEntry := differ.b.GetDynamicEntry(bID)
+		if bEntry == nil {
+			return differ.b.Err
+		}
+
+		if differ.opts.IgnoreIDs {
+			// compare references from a in terms of the IDs of b, without
+			// modifying the entry of a
+			aCopy := dynamic.NewMessage(aEntry.GetMessageDescriptor())
+			if err := aCopy.MergeFrom(aEntry); err != nil {
+				return err
+			}
+			aEntry = aCopy
+			for _, field := range registeredReferenceFields(aType) {
+				err := mapReferenceField(aEntry, strings.Split(field.path, "."), func(to uint64) uint64 {
+					if mapped, ok := differ.aToB[to]; ok {
+						return mapped
+					}
+					return to
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		differ.diffMessages(path, aEntry, bEntry)
+	}
+
+	for _, bID := range sortEntries(differ.b.AllEntries()) {
+		if _, ok := differ.bToA[bID]; !ok {
+			path := "entry[" + strconv.FormatUint(bID, 10) + "]"
+			if differ.opts.IgnoreIDs {
+				path = "entry[/" + strconv.FormatUint(bID, 10) + "]"
+			}
+			differ.add(path, nil, differ.entryTypeName(differ.b, bID))
+		}
+	}
+
+	return nil
+}
+
+func (differ *eventDiffer) entryTypeName(event *Event, id uint64) string {
+	return event.proto.Type[event.proto.Entry[id].Type]
+}
+
+func (differ *eventDiffer) diffMessages(path string, a *dynamic.Message, b *dynamic.Message) {
+	for _, fd := range a.GetMessageDescriptor().GetFields() {
+		differ.diffFields(path+"."+fd.GetName(), fd, a.GetField(fd), b.GetField(fd))
+	}
+}
+
+func (differ *eventDiffer) diffFields(path string, fd *desc.FieldDescriptor, a interface{}, b interface{}) {
+	switch {
+	case fd.IsMap():
+		aMap := a.(map[interface{}]interface{})
+		bMap := b.(map[interface{}]interface{})
+		var keys []interface{}
+		for key := range aMap {
+			keys = append(keys, key)
+		}
+		for key := range bMap {
+			if _, ok := aMap[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j]) })
+
+		valueFD := fd.GetMapValueType()
+		for _, key := range keys {
+			keyPath := path + "[" + fmt.Sprint(key) + "]"
+			aValue, aOk := aMap[key]
+			bValue, bOk := bMap[key]
+			if !aOk || !bOk {
+				differ.add(keyPath, aValue, bValue)
+				continue
+			}
+			differ.diffValues(keyPath, valueFD, aValue, bValue)
+		}
+
+	case fd.IsRepeated():
+		aSlice := a.([]interface{})
+		bSlice := b.([]interface{})
+		if len(aSlice) != len(bSlice) {
+			differ.add(path+".length", len(aSlice), len(bSlice))
+		}
+		for i := 0; i < len(aSlice) && i < len(bSlice); i++ {
+			differ.diffValues(path+"["+strconv.Itoa(i)+"]", fd, aSlice[i], bSlice[i])
+		}
+
+	default:
+		differ.diffValues(path, fd, a, b)
+	}
+}
+
+func (differ *eventDiffer) diffValues(path string, fd *desc.FieldDescriptor, a interface{}, b interface{}) {
+	if fd.GetMessageType() != nil {
+		aMsg, err := asDynamicMessage(a, fd.GetMessageType())
+		if err != nil {
+			differ.add(path, a, b)
+			return
+		}
+		bMsg, err := asDynamicMessage(b, fd.GetMessageType())
+		if err != nil {
+			differ.add(path, a, b)
+			return
+		}
+		differ.diffMessages(path, aMsg, bMsg)
+		return
+	}
+
+	equal := false
+	switch aValue := a.(type) {
+	case float32:
+		equal = differ.floatsEqual(float64(aValue), float64(b.(float32)))
+	case float64:
+		equal = differ.floatsEqual(aValue, b.(float64))
+	case []byte:
+		equal = bytes.Equal(aValue, b.([]byte))
+	default:
+		equal = a == b
+	}
+	if !equal {
+		differ.add(path, a, b)
+	}
+}
+
+func (differ *eventDiffer) floatsEqual(a float64, b float64) bool {
+	if a == b || math.IsNaN(a) && math.IsNaN(b) {
+		return true
+	}
+	return math.Abs(a-b) <= differ.opts.FloatTolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+// asDynamicMessage converts a message field value to a dynamic message, where
+// an unset value becomes an empty message of type md.
+func asDynamicMessage(value interface{}, md *desc.MessageDescriptor) (*dynamic.Message, error) {
+	if msg, ok := value.(*dynamic.Message); ok && msg != nil {
+		return msg, nil
+	}
+	msg, ok := value.(protobuf.Message)
+	if !ok || msg == nil || reflect.ValueOf(msg).IsNil() {
+		return dynamic.NewMessage(md), nil
+	}
+	return dynamic.AsDynamicMessage(msg)
+}
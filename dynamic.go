@@ -0,0 +1,131 @@
+package proio
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/dynamic"
+)
+
+// UnknownTypeError is the error of an entry whose type is neither linked with
+// the current executable nor described by any known FileDescriptorProto.
+type UnknownTypeError struct {
+	Type string
+}
+
+func (err *UnknownTypeError) Error() string {
+	return "unknown type: " + err.Type
+}
+
+// GetDynamicEntry retrieves and deserializes an entry corresponding to the
+// given ID number into a dynamic message.  Unlike GetEntry, the entry type
+// does not need to be linked with the current executable, since the message
+// is described by the FileDescriptorProtos collected from the stream.  If the
+// entry type is linked, the returned message is a copy of the entry, and
+// changes to it are not reflected in the Event.  nil is returned if the entry
+// type is not described by any known FileDescriptorProto.
+func (evt *Event) GetDynamicEntry(id uint64) *dynamic.Message {
+	entryProto, ok := evt.proto.Entry[id]
+	if !ok {
+		evt.Err = errors.New("no such entry: " + strconv.FormatUint(id, 10))
+		return nil
+	}
+
+	if entry, ok := evt.entryCache[id]; ok {
+		if dynEntry, ok := entry.(*dynamic.Message); ok {
+			evt.Err = nil
+			return dynEntry
+		}
+		dynEntry, err := dynamic.AsDynamicMessage(entry)
+		if err != nil {
+			evt.Err = err
+			return nil
+		}
+		evt.Err = nil
+		return dynEntry
+	}
+
+	typeName := evt.proto.Type[entryProto.Type]
+	dynEntry := newDynamicMessage(typeName)
+	if dynEntry == nil {
+		evt.Err = &UnknownTypeError{Type: typeName}
+		return nil
+	}
+
+	if err := dynEntry.Unmarshal(entryProto.Payload); err != nil {
+		evt.Err = errors.New(
+			"failure to unmarshal entry " +
+				strconv.FormatUint(id, 10) +
+				" with type " +
+				typeName,
+		)
+		return nil
+	}
+
+	// only cache the entry if GetEntry would not return a linked type for it
+	if evt.getPrototype(entryProto.Type) == nil {
+		evt.entryCache[id] = dynEntry
+	}
+
+	evt.Err = nil
+	return dynEntry
+}
+
+// newDynamicMessage returns an empty dynamic message of the named type, or nil
+// if the type is neither described by a stored FileDescriptorProto nor linked
+// with the current executable.
+func newDynamicMessage(typeName string) *dynamic.Message {
+	fdProto, ok := fdProtoForTypeStore.Load(typeName)
+	if !ok {
+		md, err := desc.LoadMessageDescriptor(typeName)
+		if err != nil || md == nil {
+			return nil
+		}
+		return dynamic.NewMessage(md)
+	}
+	fd, err := fileDescriptor(fdProto.(*descriptor.FileDescriptorProto))
+	if err != nil {
+		return nil
+	}
+	md := fd.FindMessage(typeName)
+	if md == nil {
+		return nil
+	}
+	return dynamic.NewMessage(md)
+}
+
+// fileDescriptor links a stored FileDescriptorProto with its dependencies.
+// Dependencies that have not been stored from a stream are taken from the
+// types linked with the current executable.
+func fileDescriptor(fdProto *descriptor.FileDescriptorProto) (*desc.FileDescriptor, error) {
+	if fd, ok := fileDescriptorStore.Load(fdProto.GetName()); ok {
+		return fd.(*desc.FileDescriptor), nil
+	}
+
+	var deps []*desc.FileDescriptor
+	for _, depName := range fdProto.GetDependency() {
+		var dep *desc.FileDescriptor
+		var err error
+		if depProto, ok := fdProtoStore.Load(depName); ok {
+			dep, err = fileDescriptor(depProto.(*descriptor.FileDescriptorProto))
+		} else {
+			dep, err = desc.LoadFileDescriptor(depName)
+		}
+		if err != nil {
+			return nil, err
+		}
+		deps = append(deps, dep)
+	}
+
+	fd, err := desc.CreateFileDescriptor(fdProto, deps...)
+	if err != nil {
+		return nil, err
+	}
+	fileDescriptorStore.Store(fdProto.GetName(), fd)
+	return fd, nil
+}
+
+var fileDescriptorStore sync.Map
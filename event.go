@@ -16,6 +16,7 @@ import (
 
 	protobuf "github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/jhump/protoreflect/dynamic"
 	proto "github.com/proio-org/go-proio-pb"
 )
 
@@ -110,9 +111,12 @@ func (evt *Event) AddEntries(tag string, entries ...protobuf.Message) []uint64 {
 }
 
 // GetEntry retrieves and deserializes an entry corresponding to the given ID
-// number.  The deserialized entry is returned.  The entry type must be one
-// that has been linked (and therefore initialized) with the current
-// executable, otherwise it is an unknown type and nil is returned.
+// number.  The deserialized entry is returned.  If the entry type has been
+// linked (and therefore initialized) with the current executable, the entry
+// is of that type.  Otherwise, the entry is a *dynamic.Message built from the
+// FileDescriptorProtos collected from the stream (see GetDynamicEntry), and
+// if the type is not described by any of them it is an unknown type and nil
+// is returned.
 func (evt *Event) GetEntry(id uint64) protobuf.Message {
 	entry, ok := evt.entryCache[uint64(id)]
 	if ok {
@@ -128,8 +132,12 @@ func (evt *Event) GetEntry(id uint64) protobuf.Message {
 
 	entry = evt.getPrototype(entryProto.Type)
 	if entry == nil {
-		evt.Err = errors.New("unknown type: " + evt.proto.Type[entryProto.Type])
-		return nil
+		dynEntry := newDynamicMessage(evt.proto.Type[entryProto.Type])
+		if dynEntry == nil {
+			evt.Err = &UnknownTypeError{Type: evt.proto.Type[entryProto.Type]}
+			return nil
+		}
+		entry = dynEntry
 	}
 
 	if err := protobuf.Unmarshal(entryProto.Payload, entry); err != nil {
@@ -209,6 +217,55 @@ func (evt *Event) TaggedEntries(tag string) []uint64 {
 	return nil
 }
 
+// TaggedEntriesUnion returns the IDs of entries that have any of the given
+// tags, in increasing order.
+func (evt *Event) TaggedEntriesUnion(tags ...string) []uint64 {
+	counts, _ := evt.tagCounts(tags)
+	var entries []uint64
+	for id := range counts {
+		entries = append(entries, id)
+	}
+	return sortEntries(entries)
+}
+
+// TaggedEntriesIntersection returns the IDs of entries that have all of the
+// given tags, in increasing order.
+func (evt *Event) TaggedEntriesIntersection(tags ...string) []uint64 {
+	counts, nTags := evt.tagCounts(tags)
+	var entries []uint64
+	for id, count := range counts {
+		if count == nTags {
+			entries = append(entries, id)
+		}
+	}
+	return sortEntries(entries)
+}
+
+// TaggedEntriesDifference returns the IDs of entries that have the given tag
+// but none of the excluded tags, in increasing order.
+func (evt *Event) TaggedEntriesDifference(tag string, excludedTags ...string) []uint64 {
+	excluded, _ := evt.tagCounts(excludedTags)
+	var entries []uint64
+	for _, id := range evt.TaggedEntries(tag) {
+		if excluded[id] == 0 {
+			entries = append(entries, id)
+		}
+	}
+	return sortEntries(entries)
+}
+
+// TagQuery returns the IDs of entries that are selected by an expression, in
+// increasing order.  The expression is typically made of tag names combined
+// with the operators & (or &&), | (or ||), ! and parentheses, e.g. "Truth &
+// !GenStable", but it may be any expression accepted by ParseSelection.
+func (evt *Event) TagQuery(expr string) ([]uint64, error) {
+	sel, err := ParseSelection(expr)
+	if err != nil {
+		return nil, err
+	}
+	return sel.SelectEntries(evt)
+}
+
 // Tags returns a list of all tags in the Event.
 func (evt *Event) Tags() []string {
 	var tags []string
@@ -285,7 +342,12 @@ func (evt *Event) String() string {
 			if entry != nil {
 				typeName := protobuf.MessageName(entry)
 				printString += "Entry type: " + typeName + "\n"
-				printString += protobuf.MarshalTextString(entry) + "\n"
+				if dynEntry, ok := entry.(*dynamic.Message); ok {
+					text, _ := dynEntry.MarshalTextIndent()
+					printString += string(text) + "\n"
+				} else {
+					printString += protobuf.MarshalTextString(entry) + "\n"
+				}
 			} else {
 				printString += evt.Err.Error() + "\n"
 			}
@@ -396,6 +458,28 @@ func (evt *Event) getTypeID(typeName string) (uint64, bool) {
 	return typeID, inStore
 }
 
+// tagCounts returns the number of the given tags that each entry ID has, along
+// with the number of distinct tags given.
+func (evt *Event) tagCounts(tags []string) (map[uint64]int, int) {
+	counts := make(map[uint64]int)
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		for _, id := range evt.TaggedEntries(tag) {
+			counts[id]++
+		}
+	}
+	return counts, len(seen)
+}
+
+func sortEntries(entries []uint64) []uint64 {
+	sort.Slice(entries, func(i, j int) bool { return entries[i] < entries[j] })
+	return entries
+}
+
 func (evt *Event) tagCleanup() {
 	if !evt.dirtyTags {
 		return
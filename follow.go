@@ -0,0 +1,77 @@
+package proio
+
+import (
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// OpenFollow is like Open, except that the Reader follows a file that is
+// still being written, in the manner of "tail -f".  When the Reader reaches
+// the end of the file, it waits for more data, checking the file for growth
+// every poll interval, rather than returning io.EOF.  Since reads wait until
+// the data is there, buckets that are only partially written are read once
+// they are complete.  Following stops when the Reader is closed, after which
+// Next returns nil with io.EOF.  Close may be called from another goroutine,
+// for example while events are scanned with ScanEvents.  The stream is not
+// seekable, and following a file that is truncated (as by OpenAppend) or
+// replaced is not supported.  poll must be positive.
+func OpenFollow(filename string, poll time.Duration) (*Reader, error) {
+	if poll <= 0 {
+		return nil, errors.New("poll interval must be positive")
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	flw := &follower{
+		file: file,
+		poll: poll,
+		quit: make(chan struct{}),
+	}
+	reader := NewReader(flw)
+	reader.sourceName = filename
+	reader.interruptRead = flw.stop
+	reader.DeferUntilClose(func() { file.Close() })
+	return reader, nil
+}
+
+// follower is an io.Reader for a growing file, which waits for more data at
+// the end of the file until it is stopped.
+type follower struct {
+	file     *os.File
+	poll     time.Duration
+	quit     chan struct{}
+	stopOnce sync.Once
+}
+
+func (flw *follower) Read(buf []byte) (int, error) {
+	for {
+		n, err := flw.file.Read(buf)
+		select {
+		case <-flw.quit:
+			return n, io.EOF
+		default:
+		}
+		if n > 0 {
+			return n, nil
+		}
+		if err != io.EOF {
+			return 0, err
+		}
+
+		select {
+		case <-flw.quit:
+			return 0, io.EOF
+		case <-time.After(flw.poll):
+		}
+	}
+}
+
+func (flw *follower) stop() {
+	flw.stopOnce.Do(func() { close(flw.quit) })
+}
@@ -0,0 +1,5 @@
+module github.com/proio-org/go-proio-pb
+
+go 1.12
+
+require github.com/golang/protobuf v1.3.1
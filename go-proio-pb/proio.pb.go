@@ -0,0 +1,1887 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proio/proto/proio.proto
+
+package proto
+
+import (
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type BucketHeader_CompType int32
+
+const (
+	BucketHeader_NONE BucketHeader_CompType = 0
+	BucketHeader_GZIP BucketHeader_CompType = 1
+	BucketHeader_LZ4  BucketHeader_CompType = 2
+	BucketHeader_LZMA BucketHeader_CompType = 3
+	BucketHeader_ZSTD BucketHeader_CompType = 4
+)
+
+var BucketHeader_CompType_name = map[int32]string{
+	0: "NONE",
+	1: "GZIP",
+	2: "LZ4",
+	3: "LZMA",
+	4: "ZSTD",
+}
+
+var BucketHeader_CompType_value = map[string]int32{
+	"NONE": 0,
+	"GZIP": 1,
+	"LZ4":  2,
+	"LZMA": 3,
+	"ZSTD": 4,
+}
+
+func (x BucketHeader_CompType) String() string {
+	return proto.EnumName(BucketHeader_CompType_name, int32(x))
+}
+
+func (BucketHeader_CompType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6f6718e19c7541c9, []int{0, 0}
+}
+
+// A BucketHeader describes the contents of the bucket that immediately
+// follows.  Metadata for the stream are also stored here.
+type BucketHeader struct {
+	// nEvents stores the number of events that are contained in the bucket.
+	NEvents uint64 `protobuf:"varint,1,opt,name=nEvents,proto3" json:"nEvents,omitempty"`
+	// bucketSize stores the number of bytes in the bucket payload.
+	BucketSize uint64 `protobuf:"varint,2,opt,name=bucketSize,proto3" json:"bucketSize,omitempty"`
+	// compression stores the enumeration of the type of compression used for
+	// this bucket.
+	Compression BucketHeader_CompType `protobuf:"varint,3,opt,name=compression,proto3,enum=proio.proto.BucketHeader_CompType" json:"compression,omitempty"`
+	// fileDescriptor stores uncompressed protobuf FileDescriptorProtos.  It is
+	// a general requirement for compatibility with proio that library
+	// implementations save all FileDescriptorProtos (and their dependencies)
+	// required to describe the data in bucket headers.  The
+	// FileDescriptorProtos must be placed into headers before they are needed.
+	FileDescriptor [][]byte `protobuf:"bytes,5,rep,name=fileDescriptor,proto3" json:"fileDescriptor,omitempty"`
+	// metadata describes key-value pairs that are to be associated with all
+	// events that follow in the stream, until the keys are overwritten or the
+	// stream ends.
+	Metadata map[string][]byte `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// checksum stores the CRC32C (Castagnoli) checksum of the bucket payload,
+	// as stored after compression.  A value of zero means that no checksum
+	// was recorded.
+	Checksum             uint32   `protobuf:"fixed32,16,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketHeader) Reset()         { *m = BucketHeader{} }
+func (m *BucketHeader) String() string { return proto.CompactTextString(m) }
+func (*BucketHeader) ProtoMessage()    {}
+func (*BucketHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f6718e19c7541c9, []int{0}
+}
+func (m *BucketHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BucketHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BucketHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BucketHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketHeader.Merge(m, src)
+}
+func (m *BucketHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *BucketHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketHeader proto.InternalMessageInfo
+
+func (m *BucketHeader) GetNEvents() uint64 {
+	if m != nil {
+		return m.NEvents
+	}
+	return 0
+}
+
+func (m *BucketHeader) GetBucketSize() uint64 {
+	if m != nil {
+		return m.BucketSize
+	}
+	return 0
+}
+
+func (m *BucketHeader) GetCompression() BucketHeader_CompType {
+	if m != nil {
+		return m.Compression
+	}
+	return BucketHeader_NONE
+}
+
+func (m *BucketHeader) GetFileDescriptor() [][]byte {
+	if m != nil {
+		return m.FileDescriptor
+	}
+	return nil
+}
+
+func (m *BucketHeader) GetMetadata() map[string][]byte {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *BucketHeader) GetChecksum() uint32 {
+	if m != nil {
+		return m.Checksum
+	}
+	return 0
+}
+
+// An Event is a container for arbitrary protobuf messages
+type Event struct {
+	// tag stores a mapping from human-readable strings to lists of number
+	// entry ids.
+	Tag map[string]*Tag `protobuf:"bytes,1,rep,name=tag,proto3" json:"tag,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// nEntries stores the number of entries that have been stored in (not that
+	// are currently stored in) the Event.  This is for a simple way to assign
+	// unique identifiers to new entries.
+	NEntries uint64 `protobuf:"varint,2,opt,name=nEntries,proto3" json:"nEntries,omitempty"`
+	// entry stores a mapping from a numeric entry id to an Any message type.
+	Entry map[uint64]*Any `protobuf:"bytes,3,rep,name=entry,proto3" json:"entry,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// nTypes stores the number of types that have been stored in (not that are
+	// currently stored in) the Event.  This is for a simple way to assign
+	// unique identifiers to new types.
+	NTypes uint64 `protobuf:"varint,4,opt,name=nTypes,proto3" json:"nTypes,omitempty"`
+	// type stores a mapping from a numeric type id to a protobuf type string.
+	Type                 map[uint64]string `protobuf:"bytes,5,rep,name=type,proto3" json:"type,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f6718e19c7541c9, []int{1}
+}
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetTag() map[string]*Tag {
+	if m != nil {
+		return m.Tag
+	}
+	return nil
+}
+
+func (m *Event) GetNEntries() uint64 {
+	if m != nil {
+		return m.NEntries
+	}
+	return 0
+}
+
+func (m *Event) GetEntry() map[uint64]*Any {
+	if m != nil {
+		return m.Entry
+	}
+	return nil
+}
+
+func (m *Event) GetNTypes() uint64 {
+	if m != nil {
+		return m.NTypes
+	}
+	return 0
+}
+
+func (m *Event) GetType() map[uint64]string {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+// A Tag is a simple list of numeric entry ids.
+type Tag struct {
+	Entry                []uint64 `protobuf:"varint,1,rep,packed,name=entry,proto3" json:"entry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Tag) Reset()         { *m = Tag{} }
+func (m *Tag) String() string { return proto.CompactTextString(m) }
+func (*Tag) ProtoMessage()    {}
+func (*Tag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f6718e19c7541c9, []int{2}
+}
+func (m *Tag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Tag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Tag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Tag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tag.Merge(m, src)
+}
+func (m *Tag) XXX_Size() int {
+	return m.Size()
+}
+func (m *Tag) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tag proto.InternalMessageInfo
+
+func (m *Tag) GetEntry() []uint64 {
+	if m != nil {
+		return m.Entry
+	}
+	return nil
+}
+
+// Any messages are a means of lazy decoding of Event entries.  The type allows
+// proio libraries to create the correct protobuf object for deserialization,
+// and the payload is to be deserialized with the object.
+type Any struct {
+	Type                 uint64   `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Payload              []byte   `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Any) Reset()         { *m = Any{} }
+func (m *Any) String() string { return proto.CompactTextString(m) }
+func (*Any) ProtoMessage()    {}
+func (*Any) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f6718e19c7541c9, []int{3}
+}
+func (m *Any) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Any) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Any.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Any) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Any.Merge(m, src)
+}
+func (m *Any) XXX_Size() int {
+	return m.Size()
+}
+func (m *Any) XXX_DiscardUnknown() {
+	xxx_messageInfo_Any.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Any proto.InternalMessageInfo
+
+func (m *Any) GetType() uint64 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *Any) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("proio.proto.BucketHeader_CompType", BucketHeader_CompType_name, BucketHeader_CompType_value)
+	proto.RegisterType((*BucketHeader)(nil), "proio.proto.BucketHeader")
+	proto.RegisterMapType((map[string][]byte)(nil), "proio.proto.BucketHeader.MetadataEntry")
+	proto.RegisterType((*Event)(nil), "proio.proto.Event")
+	proto.RegisterMapType((map[uint64]*Any)(nil), "proio.proto.Event.EntryEntry")
+	proto.RegisterMapType((map[string]*Tag)(nil), "proio.proto.Event.TagEntry")
+	proto.RegisterMapType((map[uint64]string)(nil), "proio.proto.Event.TypeEntry")
+	proto.RegisterType((*Tag)(nil), "proio.proto.Tag")
+	proto.RegisterType((*Any)(nil), "proio.proto.Any")
+}
+
+func init() { proto.RegisterFile("proio/proto/proio.proto", fileDescriptor_6f6718e19c7541c9) }
+
+var fileDescriptor_6f6718e19c7541c9 = []byte{
+	// 525 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x51, 0x8f, 0xd2, 0x4c,
+	0x14, 0xdd, 0x61, 0xa6, 0x50, 0x2e, 0x7c, 0x9b, 0xc9, 0xe4, 0x8b, 0x4e, 0x58, 0x25, 0x84, 0xc4,
+	0x95, 0x17, 0xd1, 0x80, 0x89, 0xc6, 0x7d, 0x82, 0x85, 0xb8, 0x92, 0xdd, 0x75, 0xd3, 0xe5, 0xa9,
+	0x6f, 0xb3, 0x65, 0xc4, 0x06, 0x68, 0x9b, 0xb6, 0x6c, 0x52, 0x7f, 0x89, 0x2f, 0x26, 0xfe, 0x1c,
+	0x1f, 0xfd, 0x09, 0x06, 0xff, 0x88, 0x99, 0x69, 0xc1, 0xc1, 0x60, 0xe2, 0x0b, 0xdc, 0x33, 0x3d,
+	0x73, 0xee, 0x3d, 0xe7, 0xb6, 0xf0, 0x30, 0x8a, 0x43, 0x3f, 0x7c, 0x1e, 0xc5, 0x61, 0xaa, 0x7f,
+	0xfd, 0xb0, 0xab, 0x6b, 0x56, 0x33, 0x40, 0xfb, 0x0b, 0x86, 0xfa, 0x70, 0xed, 0x2d, 0x64, 0x7a,
+	0x21, 0xc5, 0x4c, 0xc6, 0x8c, 0x43, 0x25, 0x18, 0xdf, 0xcb, 0x20, 0x4d, 0x38, 0x6a, 0xa1, 0x0e,
+	0x71, 0xb6, 0x90, 0x35, 0x01, 0xee, 0x34, 0xf3, 0xd6, 0xff, 0x24, 0x79, 0x49, 0x3f, 0x34, 0x4e,
+	0xd8, 0x08, 0x6a, 0x5e, 0xb8, 0x8a, 0x62, 0x99, 0x24, 0x7e, 0x18, 0x70, 0xdc, 0x42, 0x9d, 0xe3,
+	0x5e, 0xbb, 0x6b, 0x74, 0xeb, 0x9a, 0x9d, 0xba, 0xe7, 0xe1, 0x2a, 0x9a, 0x66, 0x91, 0x74, 0xcc,
+	0x6b, 0xec, 0x14, 0x8e, 0x3f, 0xf8, 0x4b, 0x39, 0x92, 0x89, 0x17, 0xfb, 0x51, 0x1a, 0xc6, 0xdc,
+	0x6a, 0xe1, 0x4e, 0xdd, 0xf9, 0xe3, 0x94, 0x9d, 0x83, 0xbd, 0x92, 0xa9, 0x98, 0x89, 0x54, 0xf0,
+	0x4a, 0x0b, 0x77, 0x6a, 0xbd, 0xa7, 0x7f, 0x6f, 0x75, 0x55, 0x30, 0xc7, 0x41, 0x1a, 0x67, 0xce,
+	0xee, 0x22, 0x6b, 0x80, 0xed, 0x7d, 0x94, 0xde, 0x22, 0x59, 0xaf, 0x38, 0x6d, 0xa1, 0x4e, 0xc5,
+	0xd9, 0xe1, 0xc6, 0x19, 0xfc, 0xb7, 0x77, 0x8d, 0x51, 0xc0, 0x0b, 0x99, 0xe9, 0x54, 0xaa, 0x8e,
+	0x2a, 0xd9, 0xff, 0x60, 0xdd, 0x8b, 0xe5, 0x3a, 0x0f, 0xa3, 0xee, 0xe4, 0xe0, 0x4d, 0xe9, 0x35,
+	0x6a, 0x9f, 0x81, 0xbd, 0xb5, 0xc7, 0x6c, 0x20, 0xd7, 0xef, 0xaf, 0xc7, 0xf4, 0x48, 0x55, 0x6f,
+	0xdd, 0x77, 0x37, 0x14, 0xb1, 0x0a, 0xe0, 0x4b, 0xf7, 0x25, 0x2d, 0xa9, 0xa3, 0x4b, 0xf7, 0x6a,
+	0x40, 0xb1, 0xaa, 0xdc, 0xdb, 0xe9, 0x88, 0x92, 0x09, 0xb1, 0x09, 0xb5, 0x26, 0xc4, 0x2e, 0xd3,
+	0x4a, 0xfb, 0x2b, 0x06, 0x4b, 0xe7, 0xcf, 0x9e, 0x01, 0x4e, 0xc5, 0x9c, 0x23, 0xed, 0xf5, 0x64,
+	0xcf, 0xab, 0x26, 0x74, 0xa7, 0x62, 0x9e, 0xfb, 0x53, 0x3c, 0x65, 0x2d, 0x50, 0xd0, 0x97, 0x49,
+	0xb1, 0xab, 0x1d, 0x66, 0x7d, 0xb0, 0xa4, 0x62, 0x72, 0xac, 0xc5, 0x1e, 0x1f, 0x10, 0xd3, 0x4a,
+	0xb9, 0x5c, 0xce, 0x65, 0x0f, 0xa0, 0x1c, 0x28, 0x3f, 0x09, 0x27, 0x5a, 0xae, 0x40, 0xec, 0x05,
+	0x90, 0x34, 0x8b, 0xa4, 0x5e, 0x53, 0xad, 0xf7, 0xe8, 0xd0, 0x60, 0x59, 0x24, 0x73, 0x29, 0xcd,
+	0x6c, 0x5c, 0x80, 0xbd, 0x9d, 0xf5, 0x40, 0xa8, 0xa7, 0x66, 0xa8, 0xb5, 0x1e, 0xdd, 0x13, 0x9c,
+	0x8a, 0xb9, 0x11, 0x73, 0x63, 0x02, 0xf0, 0x7b, 0x50, 0x53, 0x8b, 0xfc, 0x83, 0xd6, 0x20, 0xc8,
+	0x4c, 0xad, 0x57, 0x50, 0xdd, 0x0d, 0x7a, 0x40, 0x6a, 0x6f, 0xd7, 0x55, 0x73, 0xd7, 0x27, 0x80,
+	0xa7, 0x62, 0xae, 0x08, 0x79, 0xa8, 0x6a, 0x43, 0xa4, 0x48, 0xad, 0xdd, 0x07, 0x3c, 0x08, 0x32,
+	0xc6, 0x8a, 0x90, 0x72, 0x41, 0x5d, 0xab, 0x2f, 0x2d, 0x12, 0xd9, 0x32, 0x14, 0xb3, 0xe2, 0xfd,
+	0xd9, 0xc2, 0xe1, 0x93, 0x6f, 0x9b, 0x26, 0xfa, 0xbe, 0x69, 0xa2, 0x1f, 0x9b, 0x26, 0xfa, 0xfc,
+	0xb3, 0x79, 0x04, 0x96, 0x1e, 0x7c, 0x68, 0xdd, 0xa8, 0xc9, 0x5d, 0x4b, 0x1b, 0xb8, 0x2b, 0xeb,
+	0xbf, 0xfe, 0xaf, 0x01, 0x00, 0xf4, 0xdc, 0xa7, 0x11, 0xea, 0x03, 0x00, 0x00,
+}
+
+func (m *BucketHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BucketHeader) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.NEvents != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintProio(dAtA, i, uint64(m.NEvents))
+	}
+	if m.BucketSize != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintProio(dAtA, i, uint64(m.BucketSize))
+	}
+	if m.Compression != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintProio(dAtA, i, uint64(m.Compression))
+	}
+	if len(m.FileDescriptor) > 0 {
+		for _, b := range m.FileDescriptor {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintProio(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.Metadata) > 0 {
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0x3a
+			i++
+			v := m.Metadata[k]
+			byteSize := 0
+			if len(v) > 0 {
+				byteSize = 1 + len(v) + sovProio(uint64(len(v)))
+			}
+			mapSize := 1 + len(k) + sovProio(uint64(len(k))) + byteSize
+			i = encodeVarintProio(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintProio(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if len(v) > 0 {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintProio(dAtA, i, uint64(len(v)))
+				i += copy(dAtA[i:], v)
+			}
+		}
+	}
+	if m.Checksum != 0 {
+		dAtA[i] = 0x85
+		i++
+		dAtA[i] = 0x1
+		i++
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.Checksum))
+		i += 4
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tag) > 0 {
+		for k, _ := range m.Tag {
+			dAtA[i] = 0xa
+			i++
+			v := m.Tag[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovProio(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovProio(uint64(len(k))) + msgSize
+			i = encodeVarintProio(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintProio(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintProio(dAtA, i, uint64(v.Size()))
+				n1, err1 := v.MarshalTo(dAtA[i:])
+				if err1 != nil {
+					return 0, err1
+				}
+				i += n1
+			}
+		}
+	}
+	if m.NEntries != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintProio(dAtA, i, uint64(m.NEntries))
+	}
+	if len(m.Entry) > 0 {
+		for k, _ := range m.Entry {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Entry[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovProio(uint64(msgSize))
+			}
+			mapSize := 1 + sovProio(uint64(k)) + msgSize
+			i = encodeVarintProio(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0x8
+			i++
+			i = encodeVarintProio(dAtA, i, uint64(k))
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintProio(dAtA, i, uint64(v.Size()))
+				n2, err2 := v.MarshalTo(dAtA[i:])
+				if err2 != nil {
+					return 0, err2
+				}
+				i += n2
+			}
+		}
+	}
+	if m.NTypes != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintProio(dAtA, i, uint64(m.NTypes))
+	}
+	if len(m.Type) > 0 {
+		for k, _ := range m.Type {
+			dAtA[i] = 0x2a
+			i++
+			v := m.Type[k]
+			mapSize := 1 + sovProio(uint64(k)) + 1 + len(v) + sovProio(uint64(len(v)))
+			i = encodeVarintProio(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0x8
+			i++
+			i = encodeVarintProio(dAtA, i, uint64(k))
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintProio(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Tag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Tag) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entry) > 0 {
+		dAtA4 := make([]byte, len(m.Entry)*10)
+		var j3 int
+		for _, num := range m.Entry {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintProio(dAtA, i, uint64(j3))
+		i += copy(dAtA[i:], dAtA4[:j3])
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Any) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Any) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Type != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintProio(dAtA, i, uint64(m.Type))
+	}
+	if len(m.Payload) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintProio(dAtA, i, uint64(len(m.Payload)))
+		i += copy(dAtA[i:], m.Payload)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintProio(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *BucketHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NEvents != 0 {
+		n += 1 + sovProio(uint64(m.NEvents))
+	}
+	if m.BucketSize != 0 {
+		n += 1 + sovProio(uint64(m.BucketSize))
+	}
+	if m.Compression != 0 {
+		n += 1 + sovProio(uint64(m.Compression))
+	}
+	if len(m.FileDescriptor) > 0 {
+		for _, b := range m.FileDescriptor {
+			l = len(b)
+			n += 1 + l + sovProio(uint64(l))
+		}
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovProio(uint64(len(v)))
+			}
+			mapEntrySize := 1 + len(k) + sovProio(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovProio(uint64(mapEntrySize))
+		}
+	}
+	if m.Checksum != 0 {
+		n += 6
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tag) > 0 {
+		for k, v := range m.Tag {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovProio(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovProio(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovProio(uint64(mapEntrySize))
+		}
+	}
+	if m.NEntries != 0 {
+		n += 1 + sovProio(uint64(m.NEntries))
+	}
+	if len(m.Entry) > 0 {
+		for k, v := range m.Entry {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovProio(uint64(l))
+			}
+			mapEntrySize := 1 + sovProio(uint64(k)) + l
+			n += mapEntrySize + 1 + sovProio(uint64(mapEntrySize))
+		}
+	}
+	if m.NTypes != 0 {
+		n += 1 + sovProio(uint64(m.NTypes))
+	}
+	if len(m.Type) > 0 {
+		for k, v := range m.Type {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovProio(uint64(k)) + 1 + len(v) + sovProio(uint64(len(v)))
+			n += mapEntrySize + 1 + sovProio(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Tag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entry) > 0 {
+		l = 0
+		for _, e := range m.Entry {
+			l += sovProio(uint64(e))
+		}
+		n += 1 + sovProio(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Any) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovProio(uint64(m.Type))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovProio(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovProio(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozProio(x uint64) (n int) {
+	return sovProio(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BucketHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BucketHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BucketHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NEvents", wireType)
+			}
+			m.NEvents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NEvents |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketSize", wireType)
+			}
+			m.BucketSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compression |= BucketHeader_CompType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileDescriptor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProio
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProio
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileDescriptor = append(m.FileDescriptor, make([]byte, postIndex-iNdEx))
+			copy(m.FileDescriptor[len(m.FileDescriptor)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProio
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string][]byte)
+			}
+			var mapkey string
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProio
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthProio
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthProio
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProio
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthProio
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthProio
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipProio(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthProio
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 16:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			m.Checksum = 0
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProio
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProio
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tag == nil {
+				m.Tag = make(map[string]*Tag)
+			}
+			var mapkey string
+			var mapvalue *Tag
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProio
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthProio
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthProio
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProio
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthProio
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthProio
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Tag{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipProio(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthProio
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Tag[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NEntries", wireType)
+			}
+			m.NEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProio
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Entry == nil {
+				m.Entry = make(map[uint64]*Any)
+			}
+			var mapkey uint64
+			var mapvalue *Any
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProio
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProio
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthProio
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthProio
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Any{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipProio(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthProio
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Entry[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NTypes", wireType)
+			}
+			m.NTypes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NTypes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProio
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Type == nil {
+				m.Type = make(map[uint64]string)
+			}
+			var mapkey uint64
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProio
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProio
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthProio
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthProio
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipProio(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthProio
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Type[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProio
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Entry = append(m.Entry, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProio
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProio
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Entry) == 0 {
+					m.Entry = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProio
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Entry = append(m.Entry, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProio
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Any) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Any: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Any: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProio
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProio
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProio
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProio(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProio
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProio
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProio
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProio
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthProio
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowProio
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipProio(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthProio
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthProio = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProio   = fmt.Errorf("proto: integer overflow")
+)
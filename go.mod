@@ -4,8 +4,12 @@ go 1.12
 
 require (
 	github.com/golang/protobuf v1.3.2
+	github.com/jhump/protoreflect v1.6.0
+	github.com/klauspost/compress v1.9.8
 	github.com/pierrec/lz4 v2.3.0+incompatible
 	github.com/proio-org/go-proio-pb v0.0.0-20190409231233-b072f0d887c9
 	github.com/smira/lzma v0.0.0-20160124201817-7f0af6269940
 	go-hep.org/x/hep v0.19.0
 )
+
+replace github.com/proio-org/go-proio-pb => ./go-proio-pb
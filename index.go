@@ -0,0 +1,396 @@
+package proio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"sort"
+
+	proto "github.com/proio-org/go-proio-pb"
+)
+
+// Index describes the layout of buckets in a seekable proio stream, and
+// allows the Reader to jump directly to the bucket containing a given event.
+// An Index is built by the Reader the first time it is needed, and it can be
+// retrieved with Reader.Index() in order to be cached and later handed to
+// another Reader of the same stream with Reader.SetIndex().
+type Index struct {
+	Buckets []BucketIndex
+}
+
+// BucketIndex describes a single bucket within an Index.  Offset is the
+// position of the bucket's magic number in the stream, and FirstEvent is the
+// number of events in the stream that precede the bucket.  Metadata and
+// FileDescriptors indicate whether or not the bucket header carries metadata
+// or FileDescriptorProtos, respectively.
+type BucketIndex struct {
+	Offset          int64
+	FirstEvent      uint64
+	NEvents         uint64
+	Compression     proto.BucketHeader_CompType
+	Metadata        bool
+	FileDescriptors bool
+}
+
+// NEvents returns the total number of events described by the Index.
+func (idx *Index) NEvents() uint64 {
+	if len(idx.Buckets) == 0 {
+		return 0
+	}
+	last := idx.Buckets[len(idx.Buckets)-1]
+	return last.FirstEvent + last.NEvents
+}
+
+// MarshalBinary encodes the Index in the form that is used for the index
+// footer of a stream.
+func (idx *Index) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, binary.MaxVarintLen64)
+	data := &bytes.Buffer{}
+	putUvarint := func(value uint64) {
+		data.Write(buf[:binary.PutUvarint(buf, value)])
+	}
+
+	putUvarint(indexVersion)
+	putUvarint(uint64(len(idx.Buckets)))
+	prevOffset := int64(0)
+	for _, bucket := range idx.Buckets {
+		data.Write(buf[:binary.PutVarint(buf, bucket.Offset-prevOffset)])
+		prevOffset = bucket.Offset
+		putUvarint(bucket.NEvents)
+		putUvarint(uint64(bucket.Compression))
+
+		var flags byte
+		if bucket.Metadata {
+			flags |= indexMetadataFlag
+		}
+		if bucket.FileDescriptors {
+			flags |= indexFileDescriptorsFlag
+		}
+		data.WriteByte(flags)
+	}
+
+	return data.Bytes(), nil
+}
+
+// UnmarshalBinary decodes an Index that was encoded with MarshalBinary.
+func (idx *Index) UnmarshalBinary(data []byte) error {
+	reader := bytes.NewReader(data)
+	badIndex := errors.New("corrupt index")
+
+	version, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return badIndex
+	}
+	if version != indexVersion {
+		return errors.New("unknown index version")
+	}
+
+	nBuckets, err := binary.ReadUvarint(reader)
+	if err != nil || nBuckets > uint64(len(data)) {
+		return badIndex
+	}
+
+	buckets := make([]BucketIndex, nBuckets)
+	prevOffset := int64(0)
+	nEvents := uint64(0)
+	for i := range buckets {
+		offsetDelta, err := binary.ReadVarint(reader)
+		if err != nil {
+			return badIndex
+		}
+		bucketEvents, err := binary.ReadUvarint(reader)
+		if err != nil {
+			return badIndex
+		}
+		comp, err := binary.ReadUvarint(reader)
+		if err != nil {
+			return badIndex
+		}
+		flags, err := reader.ReadByte()
+		if err != nil {
+			return badIndex
+		}
+
+		buckets[i] = BucketIndex{
+			Offset:          prevOffset + offsetDelta,
+			FirstEvent:      nEvents,
+			NEvents:         bucketEvents,
+			Compression:     proto.BucketHeader_CompType(comp),
+			Metadata:        flags&indexMetadataFlag != 0,
+			FileDescriptors: flags&indexFileDescriptorsFlag != 0,
+		}
+		prevOffset = buckets[i].Offset
+		nEvents += bucketEvents
+	}
+
+	idx.Buckets = buckets
+	return nil
+}
+
+// findBucket returns the position in Buckets of the bucket containing the
+// given event, or len(Buckets) if the event is beyond the end of the stream.
+func (idx *Index) findBucket(event uint64) int {
+	return sort.Search(len(idx.Buckets), func(i int) bool {
+		bucket := idx.Buckets[i]
+		return bucket.FirstEvent+bucket.NEvents > event
+	})
+}
+
+// Index returns the bucket Index for the stream.  If the Reader does not have
+// one yet, it is taken from the index footer of the stream if one was written,
+// or otherwise built by scanning the bucket headers.  This requires the stream
+// to be seekable, and the stream position is restored afterwards.
+func (rdr *Reader) Index() (*Index, error) {
+	if rdr.index == nil {
+		rdr.stopPrefetch(true)
+
+		index, err := rdr.readIndexFooter()
+		if err != nil {
+			return nil, err
+		}
+		if index == nil {
+			if index, err = rdr.buildIndex(); err != nil {
+				return nil, err
+			}
+		}
+		rdr.index = index
+	}
+	return rdr.index, nil
+}
+
+// SetIndex provides the Reader with a previously built Index for the stream,
+// so that it does not need to scan the bucket headers itself.
+func (rdr *Reader) SetIndex(index *Index) {
+	rdr.index = index
+}
+
+// EventCount returns the total number of events in a seekable stream.
+func (rdr *Reader) EventCount() (uint64, error) {
+	index, err := rdr.Index()
+	if err != nil {
+		return 0, err
+	}
+	return index.NEvents(), nil
+}
+
+// SeekToEvent prepares a seekable stream to read the event with the given
+// number, counting consecutively from the start of the stream starting with
+// 0.  Unlike Skip, this does not need to visit the bucket headers between the
+// current position and the requested event.  io.EOF is returned if the event
+// is beyond the end of the stream.
+func (rdr *Reader) SeekToEvent(event uint64) error {
+	rdr.stopPrefetch(true)
+
+	index, err := rdr.Index()
+	if err != nil {
+		return err
+	}
+
+	i := index.findBucket(event)
+	if i == len(index.Buckets) {
+		return io.EOF
+	}
+
+	// replay headers of preceding buckets that carry metadata or descriptors
+	rdr.Metadata = make(map[string][]byte)
+	for _, bucket := range index.Buckets[:i] {
+		if !bucket.Metadata && !bucket.FileDescriptors {
+			continue
+		}
+		if err := rdr.seekStream(bucket.Offset); err != nil {
+			return err
+		}
+		header, _, _, err := rdr.readBareHeader()
+		if err != nil {
+			return err
+		}
+		if err := rdr.applyHeader(header); err != nil {
+			return err
+		}
+	}
+
+	if err := rdr.seekStream(index.Buckets[i].Offset); err != nil {
+		return err
+	}
+	rdr.bucketIndex = event - index.Buckets[i].FirstEvent
+	rdr.stoppedErr = nil
+	rdr.damagedBucket = nil
+	return rdr.readHeader()
+}
+
+// BucketHeaderAt reads the header of a bucket described by the Index,
+// without changing the position of the stream.  Any FileDescriptorProtos in
+// the header are added to the pool, but the Reader's metadata is not updated.
+func (rdr *Reader) BucketHeaderAt(bucket BucketIndex) (*proto.BucketHeader, error) {
+	rdr.stopPrefetch(true)
+
+	startOffset := rdr.streamOffset
+	defer rdr.seekStream(startOffset)
+
+	if err := rdr.seekStream(bucket.Offset); err != nil {
+		return nil, err
+	}
+	header, _, nSkipped, err := rdr.readBareHeader()
+	if err != nil {
+		return nil, err
+	}
+	if nSkipped != 0 {
+		return nil, errors.New("no bucket at offset")
+	}
+	for _, fdBytes := range header.FileDescriptor {
+		if err := addFDFromBytes(fdBytes); err != nil {
+			return nil, err
+		}
+	}
+	return header, nil
+}
+
+// readIndexFooter looks for an index footer at the end of a seekable stream,
+// returning a nil Index if there is no valid footer.
+func (rdr *Reader) readIndexFooter() (*Index, error) {
+	seeker, ok := rdr.streamReader.(io.Seeker)
+	if !ok {
+		return nil, errors.New("stream not seekable")
+	}
+
+	startOffset := rdr.streamOffset
+	defer rdr.seekStream(startOffset)
+
+	end, err := seeker.Seek(0, 2 /*io.SeekEnd*/)
+	if err != nil {
+		return nil, err
+	}
+	footerStart := end - int64(4+len(indexMagicBytes))
+	if footerStart < 0 {
+		return nil, nil
+	}
+
+	if err := rdr.seekStream(footerStart); err != nil {
+		return nil, err
+	}
+	footerBuf := make([]byte, 4+len(indexMagicBytes))
+	if err := rdr.readStream(footerBuf); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(footerBuf[4:], indexMagicBytes[:]) {
+		return nil, nil
+	}
+
+	indexStart := footerStart - int64(binary.LittleEndian.Uint32(footerBuf[:4]))
+	if indexStart < 0 {
+		return nil, nil
+	}
+	if err := rdr.seekStream(indexStart); err != nil {
+		return nil, err
+	}
+	indexBuf := make([]byte, footerStart-indexStart)
+	if err := rdr.readStream(indexBuf); err != nil {
+		return nil, err
+	}
+	index := &Index{}
+	if err := index.UnmarshalBinary(indexBuf); err != nil {
+		return nil, nil
+	}
+
+	// make sure that the index actually describes this stream, and not one
+	// that was concatenated with others, by checking that its last bucket
+	// ends right where the index starts
+	if len(index.Buckets) > 0 {
+		last := index.Buckets[len(index.Buckets)-1]
+		if last.Offset < 0 || last.Offset >= indexStart {
+			return nil, nil
+		}
+		if err := rdr.seekStream(last.Offset); err != nil {
+			return nil, err
+		}
+		header, offset, _, err := rdr.readBareHeader()
+		if err != nil || offset != last.Offset || rdr.streamOffset+int64(header.BucketSize) != indexStart {
+			return nil, nil
+		}
+	}
+
+	return index, nil
+}
+
+func (rdr *Reader) buildIndex() (*Index, error) {
+	seeker, ok := rdr.streamReader.(io.Seeker)
+	if !ok {
+		return nil, errors.New("stream not seekable")
+	}
+
+	startOffset := rdr.streamOffset
+	defer rdr.seekStream(startOffset)
+
+	end, err := seeker.Seek(0, 2 /*io.SeekEnd*/)
+	if err != nil {
+		return nil, err
+	}
+	if err := rdr.seekStream(0); err != nil {
+		return nil, err
+	}
+
+	index := &Index{}
+	nEvents := uint64(0)
+	for {
+		header, offset, _, err := rdr.readBareHeader()
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		} else if err != nil {
+			// skip over damaged headers
+			continue
+		}
+
+		bucketEnd := rdr.streamOffset + int64(header.BucketSize)
+		if bucketEnd > end {
+			// truncated bucket
+			break
+		}
+
+		for _, fdBytes := range header.FileDescriptor {
+			addFDFromBytes(fdBytes)
+		}
+
+		index.Buckets = append(index.Buckets, BucketIndex{
+			Offset:          offset,
+			FirstEvent:      nEvents,
+			NEvents:         header.NEvents,
+			Compression:     header.Compression,
+			Metadata:        len(header.Metadata) > 0,
+			FileDescriptors: len(header.FileDescriptor) > 0,
+		})
+		nEvents += header.NEvents
+
+		if err := rdr.seekStream(bucketEnd); err != nil {
+			return nil, err
+		}
+	}
+
+	return index, nil
+}
+
+// seekStream seeks a seekable stream to an absolute offset.
+func (rdr *Reader) seekStream(offset int64) error {
+	seeker, ok := rdr.streamReader.(io.Seeker)
+	if !ok {
+		return errors.New("stream not seekable")
+	}
+
+	n, err := seeker.Seek(offset, 0 /*io.SeekStart*/)
+	if err != nil {
+		return err
+	}
+	rdr.streamOffset = n
+	rdr.rescan = nil
+	return nil
+}
+
+const (
+	indexVersion             = 1
+	indexMetadataFlag        = 0x1
+	indexFileDescriptorsFlag = 0x2
+)
+
+// indexMagicBytes terminate an index footer.  They are deliberately different
+// from magicBytes so that the footer is not mistaken for a bucket.
+var indexMagicBytes = [...]byte{'p', 'r', 'o', 'i', 'o', 'i', 'd', 'x'}
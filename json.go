@@ -0,0 +1,132 @@
+package proio
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+
+	proto "github.com/proio-org/go-proio-pb"
+)
+
+type jsonEvent struct {
+	Metadata map[string][]byte   `json:"metadata,omitempty"`
+	Tags     map[string][]uint64 `json:"tags,omitempty"`
+	Entries  []jsonEntry         `json:"entries,omitempty"`
+}
+
+type jsonEntry struct {
+	ID      uint64          `json:"id"`
+	Type    string          `json:"type"`
+	Value   json.RawMessage `json:"value,omitempty"`
+	Payload []byte          `json:"payload,omitempty"`
+}
+
+// MarshalJSON encodes the Event as a JSON object with "metadata", "tags" and
+// "entries" members.  Metadata values are base64 encoded, and each tag maps to
+// the list of IDs of the entries it references.  Entries are listed in order
+// of ID, and each one has an "id", a "type" and a "value" member, where the
+// value is the proto3 JSON mapping of the entry.  Entry contents are encoded
+// with the FileDescriptorProtos collected from streams (see GetDynamicEntry),
+// so the entry types do not need to be linked with the current executable.
+// Entries of types that are not described by any known FileDescriptorProto are
+// encoded with a base64 "payload" member holding the serialized entry instead
+// of a value.
+func (evt *Event) MarshalJSON() ([]byte, error) {
+	evt.tagCleanup()
+
+	jsonEvt := jsonEvent{
+		Metadata: evt.Metadata,
+		Tags:     make(map[string][]uint64),
+	}
+	for tag, tagProto := range evt.proto.Tag {
+		jsonEvt.Tags[tag] = tagProto.Entry
+	}
+
+	ids := evt.AllEntries()
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		entry := jsonEntry{
+			ID:   id,
+			Type: evt.proto.Type[evt.proto.Entry[id].Type],
+		}
+		if dynEntry := evt.GetDynamicEntry(id); dynEntry != nil {
+			value, err := dynEntry.MarshalJSON()
+			if err != nil {
+				return nil, err
+			}
+			entry.Value = value
+		} else {
+			entry.Payload = evt.proto.Entry[id].Payload
+		}
+		jsonEvt.Entries = append(jsonEvt.Entries, entry)
+	}
+
+	return json.Marshal(jsonEvt)
+}
+
+// UnmarshalJSON replaces the contents of the Event with those of a JSON object
+// in the form produced by MarshalJSON.  Entries keep their IDs.  The type of
+// each entry with a "value" member must either be linked with the current
+// executable or described by a stored FileDescriptorProto.
+func (evt *Event) UnmarshalJSON(data []byte) error {
+	jsonEvt := jsonEvent{}
+	if err := json.Unmarshal(data, &jsonEvt); err != nil {
+		return err
+	}
+
+	newEvt := newEventFromProto(&proto.Event{})
+	for key, value := range jsonEvt.Metadata {
+		newEvt.Metadata[key] = value
+	}
+
+	for _, entry := range jsonEvt.Entries {
+		if entry.ID == 0 {
+			return errors.New("invalid entry ID: 0")
+		}
+		if _, ok := newEvt.proto.Entry[entry.ID]; ok {
+			return errors.New("duplicate entry ID: " + strconv.FormatUint(entry.ID, 10))
+		}
+
+		var typeID uint64
+		payload := entry.Payload
+		if len(entry.Value) > 0 {
+			dynEntry := newDynamicMessage(entry.Type)
+			if dynEntry == nil {
+				return &UnknownTypeError{Type: entry.Type}
+			}
+			if err := dynEntry.UnmarshalJSON(entry.Value); err != nil {
+				return err
+			}
+			var err error
+			if payload, err = dynEntry.Marshal(); err != nil {
+				return err
+			}
+			if typeID, err = newEvt.getTypeIDForEntry(dynEntry); err != nil {
+				return err
+			}
+		} else {
+			typeID, _ = newEvt.getTypeID(entry.Type)
+		}
+
+		newEvt.proto.Entry[entry.ID] = &proto.Any{
+			Type:    typeID,
+			Payload: payload,
+		}
+		if entry.ID > newEvt.proto.NEntries {
+			newEvt.proto.NEntries = entry.ID
+		}
+	}
+
+	for tag, ids := range jsonEvt.Tags {
+		for _, id := range ids {
+			if _, ok := newEvt.proto.Entry[id]; !ok {
+				return errors.New("tag " + tag + " references missing entry " + strconv.FormatUint(id, 10))
+			}
+		}
+		newEvt.proto.Tag[tag] = &proto.Tag{Entry: ids}
+	}
+
+	*evt = *newEvt
+	return nil
+}
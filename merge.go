@@ -0,0 +1,80 @@
+package proio
+
+import (
+	"errors"
+	"strconv"
+
+	proto "github.com/proio-org/go-proio-pb"
+)
+
+// MergeEvent adds the entries, tags and types of src to dst.  The entries of
+// src are given new IDs following the last ID of dst, in the order of their
+// IDs in src, and type IDs are translated by type name.  Registered reference
+// fields (see RegisterReferenceField) of the added entries are rewritten to
+// use the new IDs, so that relationships among the entries of src are kept,
+// and dangling references are handled according to policy.  Entries of src are
+// added to the tags of dst with the same names, while tags of src that refer to
+// missing entries are dropped.  Metadata of src is only added
+// for keys that dst does not have.  src itself is not modified (apart from its
+// entry cache being flushed).
+//
+// The mapping from src IDs to dst IDs is returned, along with the dangling
+// references that were found, which have src IDs.  If an error is returned,
+// dst is unchanged.
+func MergeEvent(dst *Event, src *Event, policy DanglingPolicy) (map[uint64]uint64, []Reference, error) {
+	if dst == src {
+		return nil, nil, errors.New("cannot merge an Event into itself")
+	}
+	src.FlushCache()
+
+	ids := sortEntries(src.AllEntries())
+	newIDs := make(map[uint64]uint64)
+	for i, id := range ids {
+		newIDs[id] = dst.proto.NEntries + uint64(i+1)
+	}
+
+	var dangling []Reference
+	entries := make(map[uint64]*proto.Any)
+	for _, id := range ids {
+		payload, entryDangling, err := src.remapReferences(id, newIDs)
+		if err != nil {
+			return nil, nil, err
+		}
+		if payload == nil {
+			payload = src.proto.Entry[id].Payload
+		}
+		dangling = append(dangling, entryDangling...)
+		entries[id] = &proto.Any{Payload: payload}
+	}
+
+	if len(dangling) > 0 && policy == FailOnDangling {
+		return nil, dangling, errors.New(strconv.Itoa(len(dangling)) + " dangling references")
+	}
+
+	for _, id := range ids {
+		entryProto := entries[id]
+		entryProto.Type, _ = dst.getTypeID(src.proto.Type[src.proto.Entry[id].Type])
+		dst.proto.Entry[newIDs[id]] = entryProto
+	}
+	dst.proto.NEntries += uint64(len(ids))
+
+	// tags of entries that do not exist are not carried over
+	for tag, tagProto := range src.proto.Tag {
+		for _, id := range tagProto.Entry {
+			if newID, ok := newIDs[id]; ok {
+				dst.TagEntry(newID, tag)
+			}
+		}
+	}
+
+	if dst.Metadata == nil && len(src.Metadata) > 0 {
+		dst.Metadata = make(map[string][]byte)
+	}
+	for key, value := range src.Metadata {
+		if _, ok := dst.Metadata[key]; !ok {
+			dst.Metadata[key] = value
+		}
+	}
+
+	return newIDs, dangling, nil
+}
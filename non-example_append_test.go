@@ -0,0 +1,178 @@
+package proio
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	proto "github.com/proio-org/go-proio-pb"
+)
+
+func checkAppendTestFile(filename string, nEvents int, t *testing.T) {
+	reader, err := Open(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	reader.SetDamagePolicy(StopAtDamage)
+
+	if n, err := reader.EventCount(); err != nil || n != uint64(nEvents) {
+		t.Errorf("Got event count %v with error %v instead of %v", n, err, nEvents)
+	}
+
+	i := 0
+	nFDs := 0
+	nBlockMetadata := 0
+	for event := reader.Next(); event != nil; event = reader.Next() {
+		checkIndexTestEvent(event, i, t)
+		if reader.bucketIndex == 1 {
+			nFDs += len(reader.BucketHeader.FileDescriptor)
+			if _, ok := reader.BucketHeader.Metadata["block"]; ok {
+				nBlockMetadata++
+			}
+		}
+		i++
+	}
+	if reader.Err != io.EOF {
+		t.Error(reader.Err)
+	}
+	if i != nEvents {
+		t.Errorf("Read %v events instead of %v", i, nEvents)
+	}
+	if nBlocks := (nEvents + 19) / 20; nFDs != 2 || nBlockMetadata != nBlocks {
+		t.Errorf("Got %v FileDescriptorProtos and %v block metadata entries", nFDs, nBlockMetadata)
+	}
+}
+
+func TestOpenAppend(t *testing.T) {
+	dir, err := ioutil.TempDir("", "proio-append")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "append.proio")
+
+	writer, err := OpenAppend(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writer.WriteIndex = true
+	writer.WriteChecksums = true
+	writer.SetCompression(LZ4)
+	if err := pushTestEvents(writer, 0, 30, 7); err != nil {
+		t.Fatal(err)
+	}
+	writer.Close()
+
+	writer, err = OpenAppend(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !writer.WriteIndex || !writer.WriteChecksums || writer.bucketHeader.Compression != proto.BucketHeader_LZ4 {
+		t.Errorf("Writer options were not restored")
+	}
+	if err := pushTestEvents(writer, 30, 20, 7); err != nil {
+		t.Fatal(err)
+	}
+	writer.Close()
+
+	checkAppendTestFile(filename, 50, t)
+}
+
+func TestOpenAppendTruncated(t *testing.T) {
+	dir, err := ioutil.TempDir("", "proio-append")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "append.proio")
+
+	writer, err := Create(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := pushTestEvents(writer, 0, 30, 7); err != nil {
+		t.Fatal(err)
+	}
+	buckets := writer.index.Buckets
+	writer.Close()
+
+	// cut the file within the last bucket, as if the writer had crashed
+	if err := os.Truncate(filename, buckets[len(buckets)-1].Offset+30); err != nil {
+		t.Fatal(err)
+	}
+	lastFirstEvent := int(buckets[len(buckets)-1].FirstEvent)
+
+	writer, err = OpenAppend(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := pushTestEvents(writer, lastFirstEvent, 50-lastFirstEvent, 7); err != nil {
+		t.Fatal(err)
+	}
+	writer.Close()
+
+	checkAppendTestFile(filename, 50, t)
+}
+
+func TestOpenAppendNotProio(t *testing.T) {
+	dir, err := ioutil.TempDir("", "proio-append")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "notes.txt")
+
+	data := []byte("not a proio file\n")
+	if err := ioutil.WriteFile(filename, data, 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	if writer, err := OpenAppend(filename); err == nil {
+		writer.Close()
+		t.Errorf("No error for appending to a file without buckets")
+	}
+	if after, err := ioutil.ReadFile(filename); err != nil || !bytes.Equal(after, data) {
+		t.Errorf("File was modified")
+	}
+}
+
+func TestOpenAppendDamaged(t *testing.T) {
+	dir, err := ioutil.TempDir("", "proio-append")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "append.proio")
+
+	writer, err := Create(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := pushTestEvents(writer, 0, 30, 7); err != nil {
+		t.Fatal(err)
+	}
+	buckets := writer.index.Buckets
+	writer.Close()
+
+	// make a bucket in the middle claim to extend past the end of the file
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data = setTestBucketSize(data, buckets[1].Offset, 1<<30)
+	if err := ioutil.WriteFile(filename, data, 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	if writer, err := OpenAppend(filename); err == nil {
+		writer.Close()
+		t.Errorf("No error for appending to a damaged file")
+	}
+	if after, err := ioutil.ReadFile(filename); err != nil || !bytes.Equal(after, data) {
+		t.Errorf("File was modified")
+	}
+}
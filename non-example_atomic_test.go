@@ -0,0 +1,84 @@
+package proio
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/proio-org/go-proio-pb/model/example"
+)
+
+func TestCreateAtomic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "proio-atomic")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "atomic.proio")
+
+	writer, err := CreateAtomic(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pushTestEvents(writer, 0, 10, 0)
+	writer.Flush()
+
+	if _, err := os.Stat(filename); !os.IsNotExist(err) {
+		t.Errorf("File exists before Close")
+	}
+	matches, _ := filepath.Glob(filepath.Join(dir, "*.proio"))
+	if len(matches) != 0 {
+		t.Errorf("Temporary file matches glob: %v", matches)
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := Open(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	if n, err := reader.EventCount(); err != nil || n != 10 {
+		t.Errorf("Got %v events with error %v", n, err)
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 1 {
+		t.Errorf("Got %v files in directory", len(files))
+	}
+}
+
+func TestCreateAtomicFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "proio-atomic")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "atomic.proio")
+	if err := ioutil.WriteFile(filename, []byte("previous"), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	writer, err := CreateAtomic(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := NewEvent()
+	event.AddEntry("Particle", &example.Particle{Pdg: 1})
+	writer.Push(event)
+
+	// make writing fail
+	writer.atomic.file.Close()
+	if err := writer.Close(); err == nil {
+		t.Errorf("Close did not fail")
+	}
+
+	contents, err := ioutil.ReadFile(filename)
+	if err != nil || string(contents) != "previous" {
+		t.Errorf("Existing file was modified")
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 1 {
+		t.Errorf("Temporary file was not removed")
+	}
+}
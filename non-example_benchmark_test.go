@@ -141,6 +141,30 @@ func BenchmarkReadWith1000EntriesLZMA(b *testing.B) {
 	doRead(reader, b)
 }
 
+func BenchmarkParallelReadWith1000EntriesGZIP(b *testing.B) {
+	buffer := &bytes.Buffer{}
+	writer := NewWriter(buffer)
+	writer.SetCompression(GZIP)
+	writer.BucketDumpThres = 0x10000
+	doWrite(writer, b, 1000)
+
+	reader := NewReader(buffer)
+	reader.SetConcurrency(4)
+	doRead(reader, b)
+}
+
+func BenchmarkParallelReadWith1000EntriesLZMA(b *testing.B) {
+	buffer := &bytes.Buffer{}
+	writer := NewWriter(buffer)
+	writer.SetCompression(LZMA)
+	writer.BucketDumpThres = 0x10000
+	doWrite(writer, b, 1000)
+
+	reader := NewReader(buffer)
+	reader.SetConcurrency(4)
+	doRead(reader, b)
+}
+
 func BenchmarkAddRemove100Entries(b *testing.B) {
 	addRemoveNEntries(b, 100)
 }
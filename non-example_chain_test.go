@@ -0,0 +1,193 @@
+package proio
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/proio-org/go-proio-pb/model/example"
+)
+
+// writeChainTestStreams writes the streams of a chain with events numbered
+// across all of them, and with the number of the stream as "file" metadata.
+func writeChainTestStreams(t *testing.T) [][]byte {
+	var streams [][]byte
+	for i, events := range [][2]int{{0, 10}, {10, 0}, {10, 7}, {17, 13}} {
+		stream, _ := writeTestStream(GZIP, events[0], events[1], 4, func(writer *Writer) error {
+			return writer.PushMetadata("file", []byte{byte(i)})
+		}, t)
+		streams = append(streams, stream)
+	}
+	return streams
+}
+
+func writeChainTestFiles(t *testing.T) (string, []string) {
+	tmpDir, err := ioutil.TempDir("", "proiotest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var filenames []string
+	for i, stream := range writeChainTestStreams(t) {
+		filename := filepath.Join(tmpDir, fmt.Sprintf("run%v.proio", i))
+		if err := ioutil.WriteFile(filename, stream, 0644); err != nil {
+			t.Fatal(err)
+		}
+		filenames = append(filenames, filename)
+	}
+	return tmpDir, filenames
+}
+
+func checkChainTestEvent(event *Event, i int, t *testing.T) {
+	if event == nil {
+		t.Errorf("Event %v failed to Get", i)
+		return
+	}
+	part, ok := event.GetEntry(event.TaggedEntries("Particle")[0]).(*example.Particle)
+	if !ok || part.Pdg != int32(i) {
+		t.Errorf("Got wrong event instead of %v", i)
+	}
+
+	file := byte(0)
+	switch {
+	case i >= 17:
+		file = 3
+	case i >= 10:
+		file = 2
+	}
+	if !bytes.Equal(event.Metadata["file"], []byte{file}) {
+		t.Errorf("Event %v has metadata %v", i, event.Metadata["file"])
+	}
+}
+
+func TestOpenFiles(t *testing.T) {
+	tmpDir, filenames := writeChainTestFiles(t)
+	defer os.RemoveAll(tmpDir)
+
+	reader, err := OpenFiles(filenames...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	for i := 0; i < 30; i++ {
+		event := reader.Next()
+		if reader.Err != nil {
+			t.Error(reader.Err)
+		}
+		checkChainTestEvent(event, i, t)
+
+		var source string
+		switch {
+		case i >= 17:
+			source = filenames[3]
+		case i >= 10:
+			source = filenames[2]
+		default:
+			source = filenames[0]
+		}
+		if reader.Source() != source {
+			t.Errorf("Event %v read from %v instead of %v", i, reader.Source(), source)
+		}
+	}
+	if event := reader.Next(); event != nil || reader.Err != io.EOF {
+		t.Errorf("Read %v with error %v after end of chain", event, reader.Err)
+	}
+}
+
+func TestOpenGlobSkip(t *testing.T) {
+	tmpDir, _ := writeChainTestFiles(t)
+	defer os.RemoveAll(tmpDir)
+
+	reader, err := OpenGlob(filepath.Join(tmpDir, "run*.proio"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	checkChainTestEvent(reader.Next(), 0, t)
+	nSkipped, err := reader.Skip(15)
+	if err != nil {
+		t.Error(err)
+	}
+	if nSkipped != 15 {
+		t.Errorf("Skipped %v events instead of %v", nSkipped, 15)
+	}
+	checkChainTestEvent(reader.Next(), 16, t)
+
+	offset := reader.BucketOffset()
+	checkChainTestEvent(reader.Next(), 17, t)
+	if reader.BucketOffset() != 0 {
+		t.Errorf("First bucket of file has offset %v", reader.BucketOffset())
+	}
+	if offset == 0 {
+		t.Errorf("Last bucket of file has offset %v", offset)
+	}
+
+	nSkipped, err = reader.Skip(100)
+	if nSkipped != 12 || err != io.EOF {
+		t.Errorf("Skipped %v events with error %v past end of chain", nSkipped, err)
+	}
+
+	if _, err := OpenGlob(filepath.Join(tmpDir, "*.notproio")); err == nil {
+		t.Errorf("No error for glob without matches")
+	}
+}
+
+func TestChainReaderScan(t *testing.T) {
+	for _, nWorkers := range []int{0, 2} {
+		streams := writeChainTestStreams(t)
+		reader := NewChainReader(
+			bytes.NewBuffer(streams[0]),
+			bytes.NewReader(streams[1]),
+			bytes.NewBuffer(streams[2]),
+			bytes.NewReader(streams[3]),
+		)
+		reader.SetConcurrency(nWorkers)
+
+		i := 0
+		for event := range reader.ScanEvents(5) {
+			checkChainTestEvent(event, i, t)
+			i++
+		}
+		if i != 30 {
+			t.Errorf("Scanned %v events instead of %v", i, 30)
+		}
+		reader.Close()
+	}
+}
+
+func TestChainReaderMetadata(t *testing.T) {
+	first, _ := writeTestStream(GZIP, 0, 10, 4, func(writer *Writer) error {
+		writer.PushMetadata("run", []byte{7})
+		return writer.PushMetadata("file", []byte{0})
+	}, t)
+	second, _ := writeTestStream(GZIP, 10, 10, 4, func(writer *Writer) error {
+		return writer.PushMetadata("file", []byte{1})
+	}, t)
+	reader := NewChainReader(bytes.NewReader(first), bytes.NewReader(second))
+	defer reader.Close()
+
+	for i := 0; i < 20; i++ {
+		event := reader.Next()
+		if event == nil {
+			t.Fatal(reader.Err)
+		}
+		if !bytes.Equal(event.Metadata["run"], []byte{7}) || !bytes.Equal(event.Metadata["file"], []byte{byte(i / 10)}) {
+			t.Errorf("Event %v has metadata %v", i, event.Metadata)
+		}
+	}
+}
+
+func TestOpenFilesMissing(t *testing.T) {
+	tmpDir, filenames := writeChainTestFiles(t)
+	defer os.RemoveAll(tmpDir)
+
+	if _, err := OpenFiles(append(filenames, filepath.Join(tmpDir, "missing.proio"))...); err == nil {
+		t.Errorf("No error for missing file")
+	}
+}
@@ -0,0 +1,99 @@
+package proio
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	proto "github.com/proio-org/go-proio-pb"
+	"github.com/proio-org/go-proio-pb/model/example"
+)
+
+func setWriteChecksums(writer *Writer) error {
+	writer.WriteChecksums = true
+	return nil
+}
+
+func TestChecksumRead(t *testing.T) {
+	for _, comp := range []Compression{UNCOMPRESSED, GZIP, LZ4, LZMA, ZSTD} {
+		for _, nWorkers := range []int{0, 2} {
+			data, _ := writeTestStream(comp, 0, 30, 10, setWriteChecksums, t)
+			reader := NewReader(bytes.NewReader(data))
+			reader.SetConcurrency(nWorkers)
+
+			i := 0
+			for event := range reader.ScanEvents(5) {
+				part := event.GetEntry(1).(*example.Particle)
+				if part.Pdg != int32(i) {
+					t.Errorf("Got event %v instead of %v", part.Pdg, i)
+				}
+				i++
+			}
+			if i != 30 || (reader.Err != nil && reader.Err != io.EOF) {
+				t.Errorf("Read %v events with error %v for compression %v", i, reader.Err, comp)
+			}
+			reader.Close()
+		}
+	}
+}
+
+func TestChecksumCorruption(t *testing.T) {
+	for _, comp := range []Compression{UNCOMPRESSED, GZIP, LZ4, LZMA, ZSTD} {
+		for _, nWorkers := range []int{0, 2} {
+			data, index := writeTestStream(comp, 0, 30, 10, setWriteChecksums, t)
+			data[index.Buckets[2].Offset-1] ^= 0x10
+
+			reader := NewReader(bytes.NewReader(data))
+			reader.SetConcurrency(nWorkers)
+
+			var pdgs []int32
+			var checksumErr *ChecksumError
+			for {
+				event := reader.Next()
+				if event != nil {
+					pdgs = append(pdgs, event.GetEntry(1).(*example.Particle).Pdg)
+					continue
+				}
+				if err, ok := reader.Err.(*ChecksumError); ok {
+					checksumErr = err
+					continue
+				}
+				break
+			}
+
+			if checksumErr == nil {
+				t.Errorf("No checksum error for compression %v", comp)
+			} else if checksumErr.Offset != index.Buckets[1].Offset || checksumErr.NEvents != 10 {
+				t.Errorf("Checksum error %v reports offset %v and %v events", checksumErr, checksumErr.Offset, checksumErr.NEvents)
+			}
+			if len(pdgs) != 20 || pdgs[9] != 9 || pdgs[10] != 20 {
+				t.Errorf("Read events %v for compression %v", pdgs, comp)
+			}
+			reader.Close()
+		}
+	}
+}
+
+func TestChecksumReplaced(t *testing.T) {
+	header := &proto.BucketHeader{}
+	setBucketChecksum(header, []byte("abc"))
+	setBucketChecksum(header, []byte("abcd"))
+
+	headerBuf, err := header.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	header = &proto.BucketHeader{}
+	if err := header.Unmarshal(headerBuf); err != nil {
+		t.Fatal(err)
+	}
+	if header.GetChecksum() == 0 || len(header.XXX_unrecognized) != 0 {
+		t.Errorf("Got header %v", header)
+	}
+	if err := verifyBucketChecksum(header, []byte("abcd"), 0); err != nil {
+		t.Error(err)
+	}
+	if err := verifyBucketChecksum(header, []byte("abc"), 0); err == nil {
+		t.Errorf("No error for wrong bytes")
+	}
+}
@@ -0,0 +1,115 @@
+package proio
+
+import (
+	"bytes"
+	"errors"
+	"runtime"
+	"testing"
+
+	proto "github.com/proio-org/go-proio-pb"
+)
+
+func writeConcurrencyTestStream(comp Compression, nWorkers int, t *testing.T) []byte {
+	stream, _ := writeTestStream(comp, 0, 200, 0, func(writer *Writer) error {
+		writer.BucketDumpThres = 0x400
+		writer.WriteIndex = true
+		return writer.SetConcurrency(nWorkers)
+	}, t)
+	return stream
+}
+
+func TestUncompConcurrentWrite(t *testing.T) {
+	concurrentWrite(UNCOMPRESSED, t)
+}
+
+func TestLZ4ConcurrentWrite(t *testing.T) {
+	concurrentWrite(LZ4, t)
+}
+
+func TestGZIPConcurrentWrite(t *testing.T) {
+	concurrentWrite(GZIP, t)
+}
+
+func TestLZMAConcurrentWrite(t *testing.T) {
+	concurrentWrite(LZMA, t)
+}
+
+func TestZSTDConcurrentWrite(t *testing.T) {
+	concurrentWrite(ZSTD, t)
+}
+
+func concurrentWrite(comp Compression, t *testing.T) {
+	syncStream := writeConcurrencyTestStream(comp, 0, t)
+	asyncStream := writeConcurrencyTestStream(comp, 4, t)
+	if !bytes.Equal(syncStream, asyncStream) {
+		t.Errorf("Concurrently written stream differs from synchronously written stream")
+	}
+
+	reader := NewReader(bytes.NewReader(asyncStream))
+	defer reader.Close()
+	nEvents, err := reader.EventCount()
+	if err != nil {
+		t.Error(err)
+	}
+	if nEvents != 200 {
+		t.Errorf("EventCount is %v instead of %v", nEvents, 200)
+	}
+	for i := 0; i < 200; i++ {
+		event := reader.Next()
+		if reader.Err != nil {
+			t.Error(reader.Err)
+		}
+		checkIndexTestEvent(event, i, t)
+	}
+}
+
+type failingWriter struct {
+	nBytes int
+}
+
+func (wrt *failingWriter) Write(p []byte) (int, error) {
+	if len(p) > wrt.nBytes {
+		n := wrt.nBytes
+		wrt.nBytes = 0
+		return n, errors.New("write failed")
+	}
+	wrt.nBytes -= len(p)
+	return len(p), nil
+}
+
+func TestConcurrentWriteError(t *testing.T) {
+	writer := NewWriter(&failingWriter{nBytes: 1000})
+	writer.BucketDumpThres = 0x100
+	writer.SetConcurrency(2)
+
+	err := pushTestEvents(writer, 0, 1000, 0)
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err == nil {
+		t.Errorf("Write error not surfaced")
+	}
+	if writer.Close() == nil {
+		t.Errorf("Write error not surfaced by Close")
+	}
+}
+
+func TestZSTDEncoderReuse(t *testing.T) {
+	bucketBytes := make([]byte, 0x2800)
+	if _, err := compressBucket(proto.BucketHeader_ZSTD, -1, bucketBytes); err != nil {
+		t.Fatal(err)
+	}
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	for i := 0; i < 20; i++ {
+		if _, err := compressBucket(proto.BucketHeader_ZSTD, -1, bucketBytes); err != nil {
+			t.Fatal(err)
+		}
+	}
+	runtime.ReadMemStats(&after)
+
+	if perBucket := (after.TotalAlloc - before.TotalAlloc) / 20; perBucket > 0x100000 {
+		t.Errorf("compressing a bucket allocated %v bytes", perBucket)
+	}
+}
@@ -0,0 +1,244 @@
+package proio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	proto "github.com/proio-org/go-proio-pb"
+	"github.com/proio-org/go-proio-pb/model/example"
+)
+
+func readDamagedStream(data []byte, policy DamagePolicy, nWorkers int) (pdgs []int32, errs []error, reports []*DamageReport, reader *Reader) {
+	reader = NewReader(bytes.NewReader(data))
+	reader.SetConcurrency(nWorkers)
+	reader.SetDamagePolicy(policy)
+	reader.SetDamageHandler(func(report *DamageReport) {
+		reports = append(reports, report)
+	})
+
+	for i := 0; i < 100; i++ {
+		event := reader.Next()
+		if event != nil {
+			pdgs = append(pdgs, event.GetEntry(1).(*example.Particle).Pdg)
+			continue
+		}
+		if reader.Err == io.EOF {
+			break
+		}
+		errs = append(errs, reader.Err)
+	}
+	reader.Close()
+	return
+}
+
+// setTestBucketSize returns a copy of a stream in which the header of the
+// bucket at the given offset claims the given bucket size.
+func setTestBucketSize(data []byte, offset int64, bucketSize uint64) []byte {
+	headerStart := offset + int64(len(magicBytes)) + 4
+	headerEnd := headerStart + int64(binary.LittleEndian.Uint32(data[headerStart-4:]))
+	header := &proto.BucketHeader{}
+	if err := header.Unmarshal(data[headerStart:headerEnd]); err != nil {
+		panic(err)
+	}
+	header.BucketSize = bucketSize
+	headerBuf, err := header.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	corrupted := append([]byte{}, data[:headerStart]...)
+	binary.LittleEndian.PutUint32(corrupted[headerStart-4:], uint32(len(headerBuf)))
+	corrupted = append(corrupted, headerBuf...)
+	return append(corrupted, data[headerEnd:]...)
+}
+
+func TestDamageResync(t *testing.T) {
+	stream, index := writeTestStream(GZIP, 0, 30, 10, setWriteChecksums, t)
+	junk := []byte("not a bucket")
+	var data []byte
+	data = append(data, stream[:index.Buckets[1].Offset]...)
+	data = append(data, junk...)
+	data = append(data, stream[index.Buckets[1].Offset:]...)
+
+	for _, nWorkers := range []int{0, 2} {
+		pdgs, errs, reports, reader := readDamagedStream(data, ReportDamage, nWorkers)
+		if len(pdgs) != 30 {
+			t.Errorf("Read %v events instead of 30", len(pdgs))
+		}
+		if len(errs) != 1 || errs[0] != ErrResynchronized {
+			t.Errorf("Got errors %v", errs)
+		}
+		if len(reports) != 1 {
+			t.Fatalf("Got %v damage reports", len(reports))
+		}
+		report := reports[0]
+		if report.Offset != index.Buckets[1].Offset || report.BytesSkipped != int64(len(junk)) || report.EventsLost != -1 {
+			t.Errorf("Got damage report %v", report)
+		}
+		if counts := reader.Damage(); counts != (DamageCounts{1, int64(len(junk)), 0}) {
+			t.Errorf("Got damage counts %v", counts)
+		}
+	}
+}
+
+func TestDamageTruncated(t *testing.T) {
+	stream, index := writeTestStream(LZ4, 0, 30, 10, setWriteChecksums, t)
+	data := stream[:index.Buckets[2].Offset-5]
+
+	for _, nWorkers := range []int{0, 2} {
+		pdgs, errs, reports, reader := readDamagedStream(data, SkipDamage, nWorkers)
+		if len(pdgs) != 10 || len(errs) != 0 {
+			t.Errorf("Read %v events with errors %v", len(pdgs), errs)
+		}
+		if len(reports) != 1 {
+			t.Fatalf("Got %v damage reports", len(reports))
+		}
+		report := reports[0]
+		if report.Offset != index.Buckets[1].Offset ||
+			report.BytesSkipped != index.Buckets[2].Offset-5-index.Buckets[1].Offset ||
+			report.EventsLost != 10 ||
+			report.Err != io.ErrUnexpectedEOF {
+			t.Errorf("Got damage report %v", report)
+		}
+		if counts := reader.Damage(); counts.EventsLost != 10 {
+			t.Errorf("Got damage counts %v", counts)
+		}
+	}
+
+	// truncated within the header of the last bucket
+	data = stream[:index.Buckets[2].Offset+20]
+	pdgs, errs, reports, _ := readDamagedStream(data, ReportDamage, 0)
+	if len(pdgs) != 20 || len(errs) != 1 || errs[0] != io.ErrUnexpectedEOF {
+		t.Errorf("Read %v events with errors %v", len(pdgs), errs)
+	}
+	if len(reports) != 1 || reports[0].Offset != index.Buckets[2].Offset || reports[0].BytesSkipped != 20 {
+		t.Errorf("Got damage reports %v", reports)
+	}
+}
+
+func TestDamageBucketSize(t *testing.T) {
+	stream, index := writeTestStream(GZIP, 0, 30, 10, setWriteChecksums, t)
+	bucketSize := uint64(index.Buckets[2].Offset - index.Buckets[1].Offset)
+
+	// make the second bucket claim to extend to the end of the stream or
+	// past it, so that the third bucket is only found by looking inside of
+	// the second one
+	for _, claimedSize := range []uint64{bucketSize, uint64(len(stream))} {
+		data := setTestBucketSize(stream, index.Buckets[1].Offset, claimedSize)
+		thirdOffset := index.Buckets[2].Offset + int64(len(data)-len(stream))
+
+		for _, seekable := range []bool{true, false} {
+			for _, nWorkers := range []int{0, 2} {
+				var streamReader io.Reader = bytes.NewReader(data)
+				if !seekable {
+					streamReader = bytes.NewBuffer(data)
+				}
+				reader := NewReader(streamReader)
+				reader.SetConcurrency(nWorkers)
+				reader.SetDamagePolicy(SkipDamage)
+				var reports []*DamageReport
+				reader.SetDamageHandler(func(report *DamageReport) {
+					reports = append(reports, report)
+				})
+
+				var pdgs []int32
+				for event := reader.Next(); event != nil; event = reader.Next() {
+					pdgs = append(pdgs, event.GetEntry(1).(*example.Particle).Pdg)
+				}
+				reader.Close()
+
+				if reader.Err != io.EOF || len(pdgs) != 20 || pdgs[10] != 20 {
+					t.Errorf("Read events %v with error %v", pdgs, reader.Err)
+				}
+				if len(reports) != 1 {
+					t.Fatalf("Got damage reports %v", reports)
+				}
+				report := reports[0]
+				if report.Offset != index.Buckets[1].Offset ||
+					report.BytesSkipped != thirdOffset-index.Buckets[1].Offset ||
+					report.EventsLost != 10 {
+					t.Errorf("Got damage report %v", report)
+				}
+			}
+		}
+	}
+}
+
+func TestDamageUndecodable(t *testing.T) {
+	data, index := writeTestStream(UNCOMPRESSED, 0, 30, 10, nil, t)
+
+	// make the first event of the second bucket claim to be larger than the
+	// bucket
+	reader := NewReader(bytes.NewReader(data))
+	header, err := reader.BucketHeaderAt(index.Buckets[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader.Close()
+	bucketStart := index.Buckets[2].Offset - int64(header.BucketSize)
+	binary.LittleEndian.PutUint32(data[bucketStart:], 0xffffff)
+
+	pdgs, errs, reports, _ := readDamagedStream(data, ReportDamage, 0)
+	if len(errs) != 1 || errs[0] != io.ErrUnexpectedEOF || len(reports) != 1 {
+		t.Fatalf("Got errors %v", errs)
+	}
+	if len(pdgs) != 20 || pdgs[10] != 20 {
+		t.Errorf("Read events %v", pdgs)
+	}
+	if reports[0].Offset != index.Buckets[1].Offset || reports[0].EventsLost != 10 {
+		t.Errorf("Got damage report %v", reports[0])
+	}
+}
+
+func TestDamageStop(t *testing.T) {
+	stream, index := writeTestStream(UNCOMPRESSED, 0, 30, 10, setWriteChecksums, t)
+	data := append([]byte{}, stream...)
+	data[index.Buckets[1].Offset-1] ^= 0x01
+
+	reader := NewReader(bytes.NewReader(data))
+	defer reader.Close()
+	reader.SetDamagePolicy(StopAtDamage)
+
+	for i := 0; i < 3; i++ {
+		if event := reader.Next(); event != nil {
+			t.Errorf("Got event past damage")
+		}
+		if _, ok := reader.Err.(*ChecksumError); !ok {
+			t.Errorf("Got error %v instead of checksum error", reader.Err)
+		}
+	}
+	if _, err := reader.Skip(1); err == nil {
+		t.Errorf("Skip past damage did not fail")
+	}
+
+	reader.SetDamagePolicy(SkipDamage)
+	if err := reader.SeekToStart(); err != nil {
+		t.Fatal(err)
+	}
+	event := reader.Next()
+	if event == nil || event.GetEntry(1).(*example.Particle).Pdg != 10 {
+		t.Errorf("Did not continue after damage: %v", reader.Err)
+	}
+	if reader.Damage().Regions != 2 {
+		t.Errorf("Got damage counts %v", reader.Damage())
+	}
+}
+
+func TestDamageIndexFooter(t *testing.T) {
+	stream, _ := writeTestStream(GZIP, 0, 10, 0, setWriteIndex, t)
+
+	for _, data := range [][]byte{stream, append(stream, 0)} {
+		pdgs, errs, reports, _ := readDamagedStream(data, ReportDamage, 0)
+		if len(pdgs) != 10 {
+			t.Errorf("Read %v events instead of 10", len(pdgs))
+		}
+		if len(data) == len(stream) && (len(errs) != 0 || len(reports) != 0) {
+			t.Errorf("Index footer reported as damage: %v", reports)
+		}
+		if len(data) != len(stream) && len(reports) != 1 {
+			t.Errorf("Trailing byte not reported as damage")
+		}
+	}
+}
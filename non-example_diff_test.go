@@ -0,0 +1,107 @@
+package proio
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/proio-org/go-proio-pb/model/example"
+)
+
+func TestDiffEventsEncoding(t *testing.T) {
+	event := refTestEvent()
+	event.Metadata["run"] = []byte{1}
+
+	buffer := &bytes.Buffer{}
+	writer := NewWriter(buffer)
+	writer.Push(event)
+	writer.SetCompression(LZMA)
+	writer.Push(event)
+	writer.Close()
+
+	reader := NewReader(buffer)
+	a := reader.Next()
+	b := reader.Next()
+	if a == nil || b == nil {
+		t.Fatal(reader.Err)
+	}
+
+	diffs, err := DiffEvents(a, b, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diffs) != 0 {
+		t.Errorf("Got differences %v", diffs)
+	}
+}
+
+func TestDiffEventsValues(t *testing.T) {
+	a := NewEvent()
+	a.AddEntry("MC", &example.Particle{Pdg: 11, Vertex: &example.XYZTF{X: 1}, Mass: 1})
+	a.AddEntry("MC", &example.Particle{Pdg: 22})
+	a.Metadata["run"] = []byte{1}
+	a.Metadata["only"] = []byte("a")
+
+	b := NewEvent()
+	b.AddEntry("MC", &example.Particle{Pdg: 13, Vertex: &example.XYZTF{X: 1.5}, Mass: 1.0001})
+	b.AddEntry("Truth", &example.Particle{Pdg: 22, Child: []uint64{1}})
+	b.AddEntry("MC", &example.XYZF{})
+	b.Metadata["run"] = []byte{2}
+
+	diffs, err := DiffEvents(a, b, &DiffOptions{FloatTolerance: 1e-3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		`metadata[only]: "a" != (none)`,
+		`metadata[run]: "\x01" != "\x02"`,
+		`tag[MC]: [1 2] != [1 3]`,
+		`tag[Truth]: (none) != [2]`,
+		`entry[1].pdg: 11 != 13`,
+		`entry[1].vertex.x: 1 != 1.5`,
+		`entry[2].child.length: 0 != 1`,
+		`entry[3]: (none) != "proio.model.example.XYZF"`,
+	}
+	if len(diffs) != len(expected) {
+		t.Fatalf("Got differences %v", diffs)
+	}
+	for i, diff := range diffs {
+		if diff.String() != expected[i] {
+			t.Errorf("Got difference %v instead of %v", diff, expected[i])
+		}
+	}
+
+	diffs, err = DiffEvents(a, b, &DiffOptions{IgnoreMetadata: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diffs) != 7 || diffs[4].Path != "entry[1].mass" {
+		t.Errorf("Got differences %v", diffs)
+	}
+}
+
+func TestDiffEventsIgnoreIDs(t *testing.T) {
+	a := refTestEvent()
+
+	b := NewEvent()
+	b.RemoveEntry(b.AddEntry("Dummy", &example.Particle{}))
+	if _, _, err := MergeEvent(b, a, NullDangling); err != nil {
+		t.Fatal(err)
+	}
+
+	diffs, err := DiffEvents(a, b, &DiffOptions{IgnoreIDs: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the dangling parent reference of entry 5 is nulled by MergeEvent
+	if len(diffs) != 1 || diffs[0].Path != "entry[5/6].parent.length" {
+		t.Errorf("Got differences %v", diffs)
+	}
+
+	diffs, err = DiffEvents(a, b, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diffs) < 7 {
+		t.Errorf("Got only differences %v", diffs)
+	}
+}
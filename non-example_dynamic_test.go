@@ -0,0 +1,146 @@
+package proio
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+
+	protobuf "github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/proio-org/go-proio-pb/model/example"
+)
+
+// unlinkedDescriptor returns a gzipped FileDescriptorProto for a message type
+// that is not linked with the test executable.
+func unlinkedDescriptor() []byte {
+	fdProto := &descriptor.FileDescriptorProto{
+		Name:       protobuf.String("proio/test/unlinked.proto"),
+		Package:    protobuf.String("proio.test"),
+		Dependency: []string{"proio/model/example/example.proto"},
+		MessageType: []*descriptor.DescriptorProto{
+			{
+				Name: protobuf.String("Unlinked"),
+				Field: []*descriptor.FieldDescriptorProto{
+					{
+						Name:   protobuf.String("energy"),
+						Number: protobuf.Int32(1),
+						Label:  descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+						Type:   descriptor.FieldDescriptorProto_TYPE_FLOAT.Enum(),
+					},
+					{
+						Name:     protobuf.String("particle"),
+						Number:   protobuf.Int32(2),
+						Label:    descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+						Type:     descriptor.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+						TypeName: protobuf.String(".proio.model.example.Particle"),
+					},
+				},
+			},
+		},
+		Syntax: protobuf.String("proto3"),
+	}
+	fdBytes, _ := protobuf.Marshal(fdProto)
+
+	buffer := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(buffer)
+	gzipWriter.Write(fdBytes)
+	gzipWriter.Close()
+	return buffer.Bytes()
+}
+
+func TestUnlinkedTypeGetEntry(t *testing.T) {
+	// energy = 2.5, particle = {pdg: 11}
+	wireData := []byte{0x0d, 0x00, 0x00, 0x20, 0x40, 0x12, 0x02, 0x18, 0x16}
+
+	event := NewEvent()
+	_, err := event.AddSerializedEntry("Unlinked", wireData, "proio.test.Unlinked", unlinkedDescriptor())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buffer := &bytes.Buffer{}
+	writer := NewWriter(buffer)
+	writer.Push(event)
+	writer.Close()
+
+	reader := NewReader(buffer)
+	defer reader.Close()
+	event = reader.Next()
+	if event == nil {
+		t.Fatal(reader.Err)
+	}
+
+	id := event.TaggedEntries("Unlinked")[0]
+	entry, ok := event.GetEntry(id).(*dynamic.Message)
+	if !ok {
+		t.Fatalf("Unlinked entry is not a dynamic message: %v", event.Err)
+	}
+	if name := protobuf.MessageName(entry); name != "proio.test.Unlinked" {
+		t.Errorf("Dynamic entry has type %v", name)
+	}
+	if energy := entry.GetFieldByName("energy"); energy != float32(2.5) {
+		t.Errorf("Dynamic entry has energy %v", energy)
+	}
+	part, ok := entry.GetFieldByName("particle").(*dynamic.Message)
+	if !ok || part.GetFieldByName("pdg") != int32(11) {
+		t.Errorf("Dynamic entry has particle %v", entry.GetFieldByName("particle"))
+	}
+
+	if !strings.Contains(event.String(), "energy: 2.5") {
+		t.Errorf("Dynamic entry not printed:\n%v", event)
+	}
+}
+
+func TestUnlinkedTypeRoundTrip(t *testing.T) {
+	wireData := []byte{0x0d, 0x00, 0x00, 0x20, 0x40}
+
+	event := NewEvent()
+	id, err := event.AddSerializedEntry("Unlinked", wireData, "proio.test.Unlinked", unlinkedDescriptor())
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry := event.GetDynamicEntry(id)
+	if entry == nil {
+		t.Fatal(event.Err)
+	}
+	entry.SetFieldByName("energy", float32(5))
+
+	buffer := &bytes.Buffer{}
+	writer := NewWriter(buffer)
+	writer.Push(event)
+	writer.Close()
+
+	reader := NewReader(buffer)
+	defer reader.Close()
+	event = reader.Next()
+	if event == nil {
+		t.Fatal(reader.Err)
+	}
+	entry = event.GetDynamicEntry(event.TaggedEntries("Unlinked")[0])
+	if entry == nil {
+		t.Fatal(event.Err)
+	}
+	if energy := entry.GetFieldByName("energy"); energy != float32(5) {
+		t.Errorf("Modified dynamic entry has energy %v", energy)
+	}
+}
+
+func TestLinkedTypeGetDynamicEntry(t *testing.T) {
+	event := NewEvent()
+	id := event.AddEntry("Particle", &example.Particle{Pdg: 13})
+
+	entry := event.GetDynamicEntry(id)
+	if entry == nil {
+		t.Fatal(event.Err)
+	}
+	if pdg := entry.GetFieldByName("pdg"); pdg != int32(13) {
+		t.Errorf("Dynamic entry has pdg %v", pdg)
+	}
+
+	event.FlushCache()
+	if _, ok := event.GetEntry(id).(*example.Particle); !ok {
+		t.Errorf("Linked entry not returned as its Go type after GetDynamicEntry")
+	}
+}
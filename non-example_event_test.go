@@ -200,6 +200,9 @@ func TestUnknownType(t *testing.T) {
 	if entry != nil {
 		t.Error("Event returns entry for unknown type")
 	}
+	if _, ok := event.Err.(*UnknownTypeError); !ok {
+		t.Errorf("Got error %v instead of unknown type error", event.Err)
+	}
 }
 
 type nonSelfSerializingMsg struct {
@@ -363,3 +366,42 @@ func TestAddSerializedEntry(t *testing.T) {
 		t.Errorf("fake message type somehow deserialized?")
 	}
 }
+
+func TestTagSetQueries(t *testing.T) {
+	event := NewEvent()
+	for i := 0; i < 6; i++ {
+		event.AddEntry("Particle", &example.Particle{Pdg: int32(i)})
+	}
+	event.TagEntry(5, "Truth")
+	event.TagEntry(2, "Truth")
+	event.TagEntry(3, "Truth", "GenStable")
+	event.TagEntry(4, "GenStable")
+
+	tests := []struct {
+		result []uint64
+		expect []uint64
+	}{
+		{event.TaggedEntriesUnion("Truth", "GenStable"), []uint64{2, 3, 4, 5}},
+		{event.TaggedEntriesIntersection("Truth", "GenStable"), []uint64{3}},
+		{event.TaggedEntriesIntersection("Truth", "Truth"), []uint64{2, 3, 5}},
+		{event.TaggedEntriesIntersection("Truth", "Missing"), nil},
+		{event.TaggedEntriesDifference("Truth", "GenStable"), []uint64{2, 5}},
+		{event.TaggedEntriesDifference("Particle", "Truth", "GenStable"), []uint64{1, 6}},
+	}
+	for i, test := range tests {
+		if !reflect.DeepEqual(test.result, test.expect) {
+			t.Errorf("Query %v returned %v instead of %v", i, test.result, test.expect)
+		}
+	}
+
+	result, err := event.TagQuery("Truth & !GenStable | Particle & !(Truth | GenStable)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(result, []uint64{1, 2, 5, 6}) {
+		t.Errorf("TagQuery returned %v", result)
+	}
+	if _, err := event.TagQuery("Truth &"); err == nil {
+		t.Errorf("No error for bad query")
+	}
+}
@@ -0,0 +1,96 @@
+package proio
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/proio-org/go-proio-pb/model/example"
+)
+
+func TestBucketMaxEvents(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	writer := NewWriter(buffer)
+	writer.BucketMaxEvents = 3
+
+	if err := pushTestEvents(writer, 0, 10, 0); err != nil {
+		t.Fatal(err)
+	}
+	writer.Close()
+
+	var nEvents []uint64
+	for _, bucket := range writer.index.Buckets {
+		nEvents = append(nEvents, bucket.NEvents)
+	}
+	if len(nEvents) != 4 || nEvents[0] != 3 || nEvents[2] != 3 || nEvents[3] != 1 {
+		t.Errorf("Got buckets with %v events", nEvents)
+	}
+
+	reader := NewReader(bytes.NewReader(buffer.Bytes()))
+	defer reader.Close()
+	if n, err := reader.EventCount(); err != nil || n != 10 {
+		t.Errorf("Got %v events with error %v", n, err)
+	}
+}
+
+func TestBucketMaxAge(t *testing.T) {
+	for _, nWorkers := range []int{0, 2} {
+		buffer := &bytes.Buffer{}
+		writer := NewWriter(buffer)
+		writer.BucketMaxAge = 10 * time.Millisecond
+		writer.SetConcurrency(nWorkers)
+
+		nBuckets := func() int {
+			writer.bucketMutex.Lock()
+			defer writer.bucketMutex.Unlock()
+			return len(writer.index.Buckets)
+		}
+
+		// the Writer is not locked by the caller, since the timer is
+		// synchronized with Push, Flush and Close by the Writer itself
+		if err := pushTestEvents(writer, 0, 2, 0); err != nil {
+			t.Fatal(err)
+		}
+
+		for start := time.Now(); nBuckets() == 0; time.Sleep(time.Millisecond) {
+			if time.Since(start) > 5*time.Second {
+				t.Fatal("Bucket was not flushed")
+			}
+		}
+
+		writer.bucketMutex.Lock()
+		if buckets := writer.index.Buckets; len(buckets) != 1 || buckets[0].NEvents != 2 {
+			t.Errorf("Got buckets %v", buckets)
+		}
+		writer.bucketMutex.Unlock()
+
+		// closing right away must not leave a flush behind
+		event := NewEvent()
+		event.AddEntry("Particle", &example.Particle{Pdg: 2})
+		writer.Push(event)
+		writer.Close()
+		time.Sleep(30 * time.Millisecond)
+
+		if n := nBuckets(); n != 2 {
+			t.Errorf("Got %v buckets instead of 2", n)
+		}
+	}
+}
+
+func TestBucketMaxAgeCloseError(t *testing.T) {
+	writer := NewWriter(&failingWriter{})
+	writer.BucketMaxAge = 10 * time.Millisecond
+
+	event := NewEvent()
+	event.AddEntry("Particle", &example.Particle{Pdg: 0})
+	writer.Push(event)
+	if err := writer.Close(); err == nil {
+		t.Errorf("No error from Close")
+	}
+
+	writer.bucketMutex.Lock()
+	defer writer.bucketMutex.Unlock()
+	if writer.ageTimer != nil {
+		t.Errorf("Age timer was not stopped by Close")
+	}
+}
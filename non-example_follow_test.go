@@ -0,0 +1,115 @@
+package proio
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/proio-org/go-proio-pb/model/example"
+)
+
+func TestOpenFollow(t *testing.T) {
+	dir, err := ioutil.TempDir("", "proio-follow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "follow.proio")
+
+	file, err := os.Create(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	// write a stream to memory and hand it to the file in small pieces, so
+	// that index.Buckets are partially written at times
+	stream, _ := writeTestStream(GZIP, 0, 30, 10, setWriteChecksums, t)
+	go func() {
+		for start := 0; start < len(stream); start += 100 {
+			end := start + 100
+			if end > len(stream) {
+				end = len(stream)
+			}
+			file.Write(stream[start:end])
+			time.Sleep(time.Millisecond)
+		}
+	}()
+
+	reader, err := OpenFollow(filename, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	closed := make(chan struct{})
+	i := 0
+	for event := range reader.ScanEvents(1) {
+		if pdg := event.GetEntry(1).(*example.Particle).Pdg; pdg != int32(i) {
+			t.Errorf("Got event %v instead of %v", pdg, i)
+		}
+		i++
+		if i == 30 {
+			// the scan must not end at the end of the file
+			go func() {
+				time.Sleep(20 * time.Millisecond)
+				close(closed)
+				reader.Close()
+			}()
+		}
+	}
+	select {
+	case <-closed:
+	default:
+		t.Errorf("Scan ended before the Reader was closed")
+	}
+	if i != 30 {
+		t.Errorf("Read %v events instead of 30", i)
+	}
+	if reader.Err != io.EOF {
+		t.Errorf("Got error %v instead of EOF", reader.Err)
+	}
+}
+
+func TestOpenFollowCloseWhileScanning(t *testing.T) {
+	file, err := ioutil.TempFile("", "proio-follow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	stream, _ := writeTestStream(GZIP, 0, 200, 1, nil, t)
+	file.Write(stream)
+	file.Close()
+
+	reader, err := OpenFollow(file.Name(), time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reader.SetConcurrency(4)
+
+	// close the Reader while the scan is still busy reading the file
+	events := reader.ScanEvents(1)
+	<-events
+	go reader.Close()
+	for range events {
+	}
+}
+
+func TestOpenFollowPoll(t *testing.T) {
+	file, err := ioutil.TempFile("", "proio-follow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file.Close()
+	defer os.Remove(file.Name())
+
+	for _, poll := range []time.Duration{0, -time.Second} {
+		if reader, err := OpenFollow(file.Name(), poll); err == nil {
+			reader.Close()
+			t.Errorf("No error for poll interval %v", poll)
+		}
+	}
+}
@@ -0,0 +1,344 @@
+package proio
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/proio-org/go-proio-pb/model/eic"
+	"github.com/proio-org/go-proio-pb/model/example"
+)
+
+// pushTestEvents pushes n test events numbered from first, flushing after
+// every flushEvery events if it is positive.  Event i has a Particle with Pdg
+// i, and i/20 as "block" metadata.  Event 20 also has a Hit, so that a second
+// FileDescriptorProto is written at a bucket boundary.
+func pushTestEvents(writer *Writer, first, n, flushEvery int) error {
+	for i := first; i < first+n; i++ {
+		event := NewEvent()
+		event.Metadata["block"] = []byte{byte(i / 20)}
+		event.AddEntry("Particle", &example.Particle{Pdg: int32(i)})
+		if i == 20 {
+			event.AddEntry("Hit", &eic.SimHit{})
+		}
+		if err := writer.Push(event); err != nil {
+			return err
+		}
+		if flushEvery > 0 && i%flushEvery == flushEvery-1 {
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// writeTestStream writes test events to a stream in memory as pushTestEvents
+// does, and returns the stream along with its index.  If setup is not nil, it
+// is called to configure the Writer first.
+func writeTestStream(comp Compression, first, n, flushEvery int, setup func(*Writer) error, t *testing.T) ([]byte, *Index) {
+	buffer := &bytes.Buffer{}
+	writer := NewWriter(buffer)
+	writer.SetCompression(comp)
+	if setup != nil {
+		if err := setup(writer); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := pushTestEvents(writer, first, n, flushEvery); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buffer.Bytes(), &writer.index
+}
+
+func setWriteIndex(writer *Writer) error {
+	writer.WriteIndex = true
+	return nil
+}
+
+func checkIndexTestEvent(event *Event, i int, t *testing.T) {
+	if event == nil {
+		t.Errorf("Event %v failed to Get", i)
+		return
+	}
+	part, ok := event.GetEntry(event.TaggedEntries("Particle")[0]).(*example.Particle)
+	if !ok || part.Pdg != int32(i) {
+		t.Errorf("Got wrong event instead of %v", i)
+	}
+	if !bytes.Equal(event.Metadata["block"], []byte{byte(i / 20)}) {
+		t.Errorf("Event %v has metadata %v", i, event.Metadata["block"])
+	}
+}
+
+func TestSeekToEvent(t *testing.T) {
+	for _, comp := range []Compression{UNCOMPRESSED, LZ4, GZIP, ZSTD} {
+		stream, _ := writeTestStream(comp, 0, 50, 7, nil, t)
+		reader := NewReader(bytes.NewReader(stream))
+
+		nEvents, err := reader.EventCount()
+		if err != nil {
+			t.Error(err)
+		}
+		if nEvents != 50 {
+			t.Errorf("EventCount is %v instead of %v", nEvents, 50)
+		}
+
+		for _, i := range []int{49, 0, 21, 20, 6, 7, 13, 40, 39} {
+			if err := reader.SeekToEvent(uint64(i)); err != nil {
+				t.Error(err)
+			}
+			event := reader.Next()
+			if reader.Err != nil {
+				t.Error(reader.Err)
+			}
+			checkIndexTestEvent(event, i, t)
+		}
+
+		if err := reader.SeekToEvent(50); err != io.EOF {
+			t.Errorf("Seeking past the end returned %v", err)
+		}
+
+		reader.Close()
+	}
+}
+
+func TestSeekToEventThenScan(t *testing.T) {
+	stream, _ := writeTestStream(GZIP, 0, 50, 7, nil, t)
+	reader := NewReader(bytes.NewReader(stream))
+	defer reader.Close()
+
+	if err := reader.SeekToEvent(17); err != nil {
+		t.Error(err)
+	}
+	i := 17
+	for event := range reader.ScanEvents(10) {
+		checkIndexTestEvent(event, i, t)
+		i++
+	}
+	if i != 50 {
+		t.Errorf("Scanned to %v instead of %v", i, 50)
+	}
+}
+
+func TestIndexPreservesPosition(t *testing.T) {
+	stream, _ := writeTestStream(LZ4, 0, 50, 7, nil, t)
+	reader := NewReader(bytes.NewReader(stream))
+	defer reader.Close()
+
+	for i := 0; i < 10; i++ {
+		checkIndexTestEvent(reader.Next(), i, t)
+	}
+	if _, err := reader.EventCount(); err != nil {
+		t.Error(err)
+	}
+	for i := 10; i < 50; i++ {
+		checkIndexTestEvent(reader.Next(), i, t)
+	}
+}
+
+func TestSetIndex(t *testing.T) {
+	stream, _ := writeTestStream(UNCOMPRESSED, 0, 50, 7, nil, t)
+
+	reader := NewReader(bytes.NewReader(stream))
+	index, err := reader.Index()
+	if err != nil {
+		t.Error(err)
+	}
+	reader.Close()
+
+	if len(index.Buckets) != 10 {
+		t.Errorf("Index has %v buckets instead of %v", len(index.Buckets), 10)
+	}
+
+	reader = NewReader(bytes.NewReader(stream))
+	defer reader.Close()
+	reader.SetIndex(index)
+	if err := reader.SeekToEvent(33); err != nil {
+		t.Error(err)
+	}
+	checkIndexTestEvent(reader.Next(), 33, t)
+}
+
+func TestIndexTruncatedFile(t *testing.T) {
+	stream, _ := writeTestStream(UNCOMPRESSED, 0, 50, 7, nil, t)
+
+	tmpDir, err := ioutil.TempDir("", "proiotest")
+	if err != nil {
+		t.Error(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	tmpFile := filepath.Join(tmpDir, "truncated")
+	if err := ioutil.WriteFile(tmpFile, stream[:len(stream)-10], 0644); err != nil {
+		t.Error(err)
+	}
+
+	reader, err := Open(tmpFile)
+	if err != nil {
+		t.Error(err)
+	}
+	defer reader.Close()
+
+	nEvents, err := reader.EventCount()
+	if err != nil {
+		t.Error(err)
+	}
+	if nEvents != 49 {
+		t.Errorf("EventCount is %v instead of %v", nEvents, 49)
+	}
+}
+
+func TestIndexNotSeekable(t *testing.T) {
+	stream, _ := writeTestStream(GZIP, 0, 50, 7, nil, t)
+	reader := NewReader(bytes.NewBuffer(stream))
+	defer reader.Close()
+
+	if err := reader.SeekToEvent(3); err == nil {
+		t.Errorf("No error for seeking in non-seekable stream")
+	}
+}
+
+func TestIndexFooter(t *testing.T) {
+	stream, writtenIndex := writeTestStream(GZIP, 0, 50, 7, setWriteIndex, t)
+
+	reader := NewReader(bytes.NewReader(stream))
+	defer reader.Close()
+
+	footerIndex, err := reader.readIndexFooter()
+	if err != nil {
+		t.Error(err)
+	}
+	if footerIndex == nil {
+		t.Fatal("Index footer not found")
+	}
+	if !reflect.DeepEqual(footerIndex, writtenIndex) {
+		t.Errorf("Index footer does not match the written index")
+	}
+
+	scannedIndex, err := reader.buildIndex()
+	if err != nil {
+		t.Error(err)
+	}
+	if !reflect.DeepEqual(footerIndex, scannedIndex) {
+		t.Errorf("Index footer does not match the scanned index")
+	}
+
+	for _, i := range []int{45, 3, 20, 19} {
+		if err := reader.SeekToEvent(uint64(i)); err != nil {
+			t.Error(err)
+		}
+		checkIndexTestEvent(reader.Next(), i, t)
+	}
+}
+
+func TestIndexFooterSequentialRead(t *testing.T) {
+	stream, _ := writeTestStream(LZ4, 0, 50, 7, setWriteIndex, t)
+
+	reader := NewReader(bytes.NewBuffer(stream))
+	defer reader.Close()
+
+	for i := 0; i < 50; i++ {
+		event := reader.Next()
+		if reader.Err != nil {
+			t.Error(reader.Err)
+		}
+		checkIndexTestEvent(event, i, t)
+	}
+	if event := reader.Next(); event != nil || reader.Err != io.EOF {
+		t.Errorf("Index footer read as %v with error %v", event, reader.Err)
+	}
+}
+
+func TestIndexFooterMismatch(t *testing.T) {
+	stream, _ := writeTestStream(UNCOMPRESSED, 0, 50, 7, setWriteIndex, t)
+
+	// shift all of the buckets so that the footer offsets are wrong
+	stream = append([]byte("asdf"), stream...)
+
+	reader := NewReader(bytes.NewReader(stream))
+	defer reader.Close()
+
+	footerIndex, err := reader.readIndexFooter()
+	if err != nil {
+		t.Error(err)
+	}
+	if footerIndex != nil {
+		t.Errorf("Mismatched index footer accepted")
+	}
+
+	nEvents, err := reader.EventCount()
+	if err != nil {
+		t.Error(err)
+	}
+	if nEvents != 50 {
+		t.Errorf("EventCount is %v instead of %v", nEvents, 50)
+	}
+}
+
+func TestIndexFooterConcatenated(t *testing.T) {
+	stream, _ := writeTestStream(LZ4, 0, 50, 7, setWriteIndex, t)
+	stream = append(stream, stream...)
+
+	reader := NewReader(bytes.NewReader(stream))
+	defer reader.Close()
+
+	nEvents, err := reader.EventCount()
+	if err != nil {
+		t.Error(err)
+	}
+	if nEvents != 100 {
+		t.Errorf("EventCount is %v instead of %v", nEvents, 100)
+	}
+
+	for _, i := range []int{95, 3, 70, 49} {
+		if err := reader.SeekToEvent(uint64(i)); err != nil {
+			t.Error(err)
+		}
+		checkIndexTestEvent(reader.Next(), i%50, t)
+	}
+
+	reader = NewReader(bytes.NewBuffer(stream))
+	defer reader.Close()
+	reader.SetDamageHandler(func(report *DamageReport) {
+		t.Errorf("Unexpected damage: %v", report)
+	})
+
+	for i := 0; i < 100; i++ {
+		event := reader.Next()
+		if reader.Err != nil {
+			t.Error(reader.Err)
+		}
+		checkIndexTestEvent(event, i%50, t)
+	}
+	if event := reader.Next(); event != nil || reader.Err != io.EOF {
+		t.Errorf("Index footer read as %v with error %v", event, reader.Err)
+	}
+}
+
+func TestIndexMarshalBinary(t *testing.T) {
+	_, index := writeTestStream(UNCOMPRESSED, 0, 50, 7, setWriteIndex, t)
+
+	data, err := index.MarshalBinary()
+	if err != nil {
+		t.Error(err)
+	}
+	newIndex := &Index{}
+	if err := newIndex.UnmarshalBinary(data); err != nil {
+		t.Error(err)
+	}
+	if !reflect.DeepEqual(index, newIndex) {
+		t.Errorf("Index changed after round trip")
+	}
+
+	if err := newIndex.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Errorf("Truncated index not caught")
+	}
+}
@@ -0,0 +1,116 @@
+package proio
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/proio-org/go-proio-pb/model/example"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	event := NewEvent()
+	event.Metadata["run"] = []byte{1, 2, 3}
+	partID := event.AddEntry("Particle", &example.Particle{Pdg: 11, Charge: -1})
+	event.TagEntry(partID, "Electron")
+	event.AddEntry("Particle", &example.Particle{Pdg: 22, Parent: []uint64{partID}})
+	unlinkedID, err := event.AddSerializedEntry(
+		"Unlinked",
+		[]byte{0x0d, 0x00, 0x00, 0x20, 0x40},
+		"proio.test.Unlinked",
+		unlinkedDescriptor(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unknownID := event.AddEntry("Unknown", &unknownMsg{})
+	event.FlushCache()
+	event.proto.Entry[unknownID].Payload = []byte{0x08, 0x01}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newEvent := NewEvent()
+	if err := json.Unmarshal(data, newEvent); err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(newEvent.Metadata["run"], []byte{1, 2, 3}) {
+		t.Errorf("Metadata is %v", newEvent.Metadata)
+	}
+	if ids := newEvent.TaggedEntries("Electron"); len(ids) != 1 || ids[0] != partID {
+		t.Errorf("Electron tag has entries %v", ids)
+	}
+	if len(newEvent.TaggedEntries("Particle")) != 2 {
+		t.Errorf("Particle tag has entries %v", newEvent.TaggedEntries("Particle"))
+	}
+
+	part, ok := newEvent.GetEntry(partID).(*example.Particle)
+	if !ok || part.Pdg != 11 || part.Charge != -1 {
+		t.Errorf("Particle entry is %v", newEvent.GetEntry(partID))
+	}
+	unlinked, ok := newEvent.GetEntry(unlinkedID).(*dynamic.Message)
+	if !ok || unlinked.GetFieldByName("energy") != float32(2.5) {
+		t.Errorf("Unlinked entry is %v", newEvent.GetEntry(unlinkedID))
+	}
+	if payload := newEvent.proto.Entry[unknownID].Payload; !bytes.Equal(payload, []byte{0x08, 0x01}) {
+		t.Errorf("Unknown entry payload is %v", payload)
+	}
+
+	newData, err := json.Marshal(newEvent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, newData) {
+		t.Errorf("JSON changed after round trip:\n%s\n%s", data, newData)
+	}
+}
+
+func TestJSONFixture(t *testing.T) {
+	fixture := `{
+		"metadata": {"name": "Zml4dHVyZQ=="},
+		"tags": {"Particle": [3, 7], "Primary": [3]},
+		"entries": [
+			{"id": 3, "type": "proio.model.example.Particle", "value": {"pdg": 2212, "vertex": {"z": 1.5}}},
+			{"id": 7, "type": "proio.model.example.Particle", "value": {"parent": ["3"], "pdg": -11}}
+		]
+	}`
+
+	event := NewEvent()
+	if err := json.Unmarshal([]byte(fixture), event); err != nil {
+		t.Fatal(err)
+	}
+
+	if string(event.Metadata["name"]) != "fixture" {
+		t.Errorf("Metadata is %v", event.Metadata)
+	}
+	part, ok := event.GetEntry(3).(*example.Particle)
+	if !ok || part.Pdg != 2212 || part.Vertex.GetZ() != 1.5 {
+		t.Errorf("Entry 3 is %v", event.GetEntry(3))
+	}
+	part, ok = event.GetEntry(7).(*example.Particle)
+	if !ok || part.Pdg != -11 || len(part.Parent) != 1 || part.Parent[0] != 3 {
+		t.Errorf("Entry 7 is %v", event.GetEntry(7))
+	}
+
+	// new entries must not collide with the fixture IDs
+	if id := event.AddEntry("Particle", &example.Particle{}); id != 8 {
+		t.Errorf("New entry has ID %v", id)
+	}
+}
+
+func TestJSONBadFixture(t *testing.T) {
+	for _, fixture := range []string{
+		`{"tags": {"Particle": [1]}}`,
+		`{"entries": [{"id": 1, "type": "proio.test.NotReal", "value": {}}]}`,
+		`{"entries": [{"id": 1, "type": "proio.model.example.Particle", "value": {"notAField": 1}}]}`,
+		`{"entries": [{"id": 0, "type": "proio.model.example.Particle", "value": {}}]}`,
+	} {
+		if err := json.Unmarshal([]byte(fixture), NewEvent()); err == nil {
+			t.Errorf("No error for fixture %v", fixture)
+		}
+	}
+}
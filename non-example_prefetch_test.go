@@ -0,0 +1,192 @@
+package proio
+
+import (
+	"bytes"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/proio-org/go-proio-pb/model/example"
+)
+
+func TestUncompParallelRead(t *testing.T) {
+	parallelRead(UNCOMPRESSED, t)
+}
+
+func TestLZ4ParallelRead(t *testing.T) {
+	parallelRead(LZ4, t)
+}
+
+func TestGZIPParallelRead(t *testing.T) {
+	parallelRead(GZIP, t)
+}
+
+func TestLZMAParallelRead(t *testing.T) {
+	parallelRead(LZMA, t)
+}
+
+func TestZSTDParallelRead(t *testing.T) {
+	parallelRead(ZSTD, t)
+}
+
+func parallelRead(comp Compression, t *testing.T) {
+	stream, _ := writeTestStream(comp, 0, 50, 7, nil, t)
+	reader := NewReader(bytes.NewBuffer(stream))
+	defer reader.Close()
+	reader.SetConcurrency(3)
+
+	for i := 0; i < 50; i++ {
+		event := reader.Next()
+		if reader.Err != nil {
+			t.Error(reader.Err)
+		}
+		checkIndexTestEvent(event, i, t)
+	}
+	if event := reader.Next(); event != nil {
+		t.Errorf("Got event past the end of the stream")
+	}
+}
+
+func TestParallelSkip(t *testing.T) {
+	stream, _ := writeTestStream(GZIP, 0, 50, 7, nil, t)
+	reader := NewReader(bytes.NewBuffer(stream))
+	defer reader.Close()
+	reader.SetConcurrency(2)
+
+	i := 0
+	for _, nSkip := range []uint64{3, 0, 10, 1, 6, 8} {
+		nSkipped, err := reader.Skip(nSkip)
+		if err != nil {
+			t.Error(err)
+		}
+		if nSkipped != nSkip {
+			t.Errorf("Skipped %v instead of %v", nSkipped, nSkip)
+		}
+		i += int(nSkip)
+		checkIndexTestEvent(reader.Next(), i, t)
+		i++
+	}
+}
+
+func TestParallelScan(t *testing.T) {
+	stream, _ := writeTestStream(LZ4, 0, 50, 7, nil, t)
+	reader := NewReader(bytes.NewBuffer(stream))
+	defer reader.Close()
+	reader.SetConcurrency(4)
+
+	i := 0
+	for event := range reader.ScanEvents(10) {
+		checkIndexTestEvent(event, i, t)
+		i++
+	}
+	if i != 50 {
+		t.Errorf("Scanned %v events instead of %v", i, 50)
+	}
+}
+
+func TestParallelSeek(t *testing.T) {
+	stream, _ := writeTestStream(GZIP, 0, 50, 7, nil, t)
+	reader := NewReader(bytes.NewReader(stream))
+	defer reader.Close()
+	reader.SetConcurrency(2)
+
+	for i := 0; i < 5; i++ {
+		checkIndexTestEvent(reader.Next(), i, t)
+	}
+	nEvents, err := reader.EventCount()
+	if err != nil {
+		t.Error(err)
+	}
+	if nEvents != 50 {
+		t.Errorf("EventCount is %v instead of %v", nEvents, 50)
+	}
+	for i := 5; i < 10; i++ {
+		checkIndexTestEvent(reader.Next(), i, t)
+	}
+
+	if err := reader.SeekToEvent(42); err != nil {
+		t.Error(err)
+	}
+	for i := 42; i < 50; i++ {
+		checkIndexTestEvent(reader.Next(), i, t)
+	}
+
+	if err := reader.SeekToStart(); err != nil {
+		t.Error(err)
+	}
+	for i := 0; i < 50; i++ {
+		checkIndexTestEvent(reader.Next(), i, t)
+	}
+}
+
+func TestParallelResync(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	buffer.Write([]byte("asdf"))
+	writer := NewWriter(buffer)
+	event := NewEvent()
+	event.AddEntry("Particle", &example.Particle{Pdg: 0})
+	writer.Push(event)
+	writer.Close()
+
+	reader := NewReader(buffer)
+	defer reader.Close()
+	reader.SetConcurrency(2)
+
+	event = reader.Next()
+	if reader.Err == nil {
+		t.Errorf("unreported resync error")
+	}
+
+	event = reader.Next()
+	if event == nil {
+		t.Errorf("failure to resync")
+	}
+
+	event = reader.Next()
+	if event != nil {
+		t.Errorf("failure to resync")
+	}
+}
+
+func TestParallelStopEarly(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	writer := NewWriter(buffer)
+	writer.BucketDumpThres = 0
+	pushTestEvents(writer, 0, 100, 0)
+	writer.Close()
+
+	reader := NewReader(buffer)
+	reader.SetConcurrency(2)
+	reader.Next()
+	reader.Close()
+}
+
+// slowStream is a slowly read stream that reports reads after it is closed.
+type slowStream struct {
+	io.Reader
+	closed int32
+	t      *testing.T
+}
+
+func (stream *slowStream) Read(p []byte) (int, error) {
+	time.Sleep(time.Millisecond)
+	if atomic.LoadInt32(&stream.closed) != 0 {
+		stream.t.Error("Stream read after the Reader was closed")
+	}
+	return stream.Reader.Read(p)
+}
+
+func TestParallelCloseInFlight(t *testing.T) {
+	buf, _ := writeTestStream(UNCOMPRESSED, 0, 100, 1, nil, t)
+	stream := &slowStream{Reader: bytes.NewReader(buf), t: t}
+
+	reader := NewReader(stream)
+	reader.DeferUntilClose(func() { atomic.StoreInt32(&stream.closed, 1) })
+	reader.SetConcurrency(2)
+	reader.Next()
+	reader.Close()
+
+	// give a prefetch that is still running the time to read again
+	time.Sleep(20 * time.Millisecond)
+}
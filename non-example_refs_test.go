@@ -0,0 +1,263 @@
+package proio
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/proio-org/go-proio-pb/model/example"
+	prolcio "github.com/proio-org/go-proio-pb/model/lcio"
+)
+
+// refTestEvent makes a decay chain 1 -> {2, 3}, 3 -> {4, 5}, with entry 6
+// unrelated, entry 5 listing a parent that is missing, and entry 7 a calorimeter
+// hit with contributions from 4 and 5.
+func refTestEvent() *Event {
+	event := NewEvent()
+	event.AddEntry("MC", &example.Particle{Pdg: 25, Child: []uint64{2, 3}})
+	event.AddEntry("MC", &example.Particle{Pdg: 22, Parent: []uint64{1}})
+	event.AddEntry("MC", &example.Particle{Pdg: 23, Parent: []uint64{1}})
+	event.AddEntry("MC", &example.Particle{Pdg: 11, Parent: []uint64{3}})
+	event.AddEntry("MC", &example.Particle{Pdg: -11, Parent: []uint64{3, 99}})
+	event.AddEntry("MC", &example.Particle{Pdg: 2212})
+	event.AddEntry("Hits", &prolcio.SimCalorimeterHit{
+		Contributions: []*prolcio.SimCalorimeterHit_Contrib{{MCParticle: 4}, {MCParticle: 5}, {}},
+	})
+	return event
+}
+
+func TestReferenceGraph(t *testing.T) {
+	event := refTestEvent()
+	graph, err := event.ReferenceGraph()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		result []uint64
+		expect []uint64
+	}{
+		{graph.Children(1), []uint64{2, 3}},
+		{graph.Parents(5), []uint64{3}},
+		{graph.Parents(1), nil},
+		{graph.Descendants(1), []uint64{2, 3, 4, 5}},
+		{graph.Ancestors(5), []uint64{1, 3}},
+		{graph.Ancestors(6), nil},
+	}
+	for i, test := range tests {
+		if !reflect.DeepEqual(test.result, test.expect) {
+			t.Errorf("Query %v returned %v instead of %v", i, test.result, test.expect)
+		}
+	}
+
+	dangling := graph.Dangling()
+	if len(dangling) != 1 || dangling[0] != (Reference{5, 99, "parent", ParentReference}) {
+		t.Errorf("Got dangling references %v", dangling)
+	}
+
+	refs := graph.References(7)
+	expected := []Reference{
+		{7, 4, "contributions.MCParticle", GenericReference},
+		{7, 5, "contributions.MCParticle", GenericReference},
+	}
+	if !reflect.DeepEqual(refs, expected) {
+		t.Errorf("Got references %v", refs)
+	}
+
+	order, err := graph.TopologicalOrder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(order, []uint64{1, 2, 3, 4, 5, 6, 7}) {
+		t.Errorf("Got order %v", order)
+	}
+}
+
+func TestReferenceGraphCycle(t *testing.T) {
+	event := NewEvent()
+	event.AddEntry("MC", &example.Particle{Child: []uint64{2}})
+	event.AddEntry("MC", &example.Particle{Child: []uint64{1}})
+	event.AddEntry("MC", &example.Particle{})
+
+	graph, err := event.ReferenceGraph()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := graph.TopologicalOrder(); err == nil {
+		t.Errorf("No error for cycle")
+	}
+	if ancestors := graph.Ancestors(1); !reflect.DeepEqual(ancestors, []uint64{1, 2}) {
+		t.Errorf("Got ancestors %v", ancestors)
+	}
+}
+
+func TestRegisterReferenceField(t *testing.T) {
+	RegisterReferenceField("proio.model.example.XYZF", "x", GenericReference)
+	defer func() {
+		referenceFieldsMutex.Lock()
+		delete(referenceFields, "proio.model.example.XYZF")
+		referenceFieldsMutex.Unlock()
+	}()
+
+	event := NewEvent()
+	event.AddEntry("Vector", &example.XYZF{X: 1})
+	if _, err := event.EntryReferences(1); err == nil {
+		t.Errorf("No error for float reference field")
+	}
+
+	RegisterReferenceField("proio.model.example.XYZF", "x", ParentReference)
+	if fields := registeredReferenceFields("proio.model.example.XYZF"); len(fields) != 1 || fields[0].kind != ParentReference {
+		t.Errorf("Got registered fields %v", fields)
+	}
+}
+
+func TestCompact(t *testing.T) {
+	event := refTestEvent()
+	event.TagEntry(5, "Positron")
+	event.RemoveEntry(2)
+	event.RemoveEntry(6)
+
+	if _, err := event.Compact(FailOnDangling); err == nil {
+		t.Errorf("No error for dangling references")
+	}
+	if part := event.GetEntry(5).(*example.Particle); !reflect.DeepEqual(part.Parent, []uint64{3, 99}) {
+		t.Errorf("Failed Compact changed entry to %v", part)
+	}
+
+	dangling, err := event.Compact(NullDangling)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Reference{
+		{1, 2, "child", ChildReference},
+		{5, 99, "parent", ParentReference},
+	}
+	if !reflect.DeepEqual(dangling, expected) {
+		t.Errorf("Got dangling references %v", dangling)
+	}
+
+	buffer := &bytes.Buffer{}
+	writer := NewWriter(buffer)
+	writer.Push(event)
+	writer.Close()
+	event = NewReader(buffer).Next()
+
+	if ids := event.AllEntries(); len(ids) != 5 {
+		t.Errorf("Got entries %v", ids)
+	}
+	if ids := event.TaggedEntries("MC"); !reflect.DeepEqual(ids, []uint64{1, 2, 3, 4}) {
+		t.Errorf("Got MC entries %v", ids)
+	}
+	if ids := event.TaggedEntries("Positron"); !reflect.DeepEqual(ids, []uint64{4}) {
+		t.Errorf("Got Positron entries %v", ids)
+	}
+	if part := event.GetEntry(1).(*example.Particle); part.Pdg != 25 || !reflect.DeepEqual(part.Child, []uint64{2}) {
+		t.Errorf("Got entry 1 %v", part)
+	}
+	if part := event.GetEntry(4).(*example.Particle); part.Pdg != -11 || !reflect.DeepEqual(part.Parent, []uint64{2}) {
+		t.Errorf("Got entry 4 %v", part)
+	}
+	hit := event.GetEntry(5).(*prolcio.SimCalorimeterHit)
+	if len(hit.Contributions) != 3 || hit.Contributions[0].MCParticle != 3 || hit.Contributions[1].MCParticle != 4 {
+		t.Errorf("Got entry 5 %v", hit)
+	}
+
+	id := event.AddEntry("MC", &example.Particle{})
+	if id != 6 {
+		t.Errorf("Added entry with ID %v after Compact", id)
+	}
+}
+
+func TestCompactDanglingTag(t *testing.T) {
+	event := refTestEvent()
+	event.TagEntry(42, "Ghost")
+	event.TagEntry(6, "Ghost")
+
+	if _, err := event.Compact(NullDangling); err != nil {
+		t.Fatal(err)
+	}
+	if tagged := event.proto.Tag["Ghost"].Entry; !reflect.DeepEqual(tagged, []uint64{6}) {
+		t.Errorf("Got Ghost entries %v", tagged)
+	}
+}
+
+func TestMergeEvent(t *testing.T) {
+	dst := NewEvent()
+	dst.AddEntry("Hits", &prolcio.SimCalorimeterHit{})
+	dst.AddEntry("MC", &example.Particle{Pdg: 2212, Child: []uint64{1}})
+	dst.Metadata["run"] = []byte{1}
+
+	src := refTestEvent()
+	src.Metadata["run"] = []byte{2}
+	src.Metadata["sample"] = []byte("background")
+
+	if _, _, err := MergeEvent(dst, src, FailOnDangling); err == nil {
+		t.Errorf("No error for dangling references")
+	}
+	if len(dst.AllEntries()) != 2 {
+		t.Errorf("Failed merge changed destination")
+	}
+
+	newIDs, dangling, err := MergeEvent(dst, src, NullDangling)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dangling) != 1 || dangling[0] != (Reference{5, 99, "parent", ParentReference}) {
+		t.Errorf("Got dangling references %v", dangling)
+	}
+	for id := uint64(1); id <= 7; id++ {
+		if newIDs[id] != id+2 {
+			t.Errorf("Entry %v mapped to %v", id, newIDs[id])
+		}
+	}
+
+	buffer := &bytes.Buffer{}
+	writer := NewWriter(buffer)
+	writer.Push(dst)
+	writer.Close()
+	event := NewReader(buffer).Next()
+
+	if ids := event.TaggedEntries("MC"); !reflect.DeepEqual(ids, []uint64{2, 3, 4, 5, 6, 7, 8}) {
+		t.Errorf("Got MC entries %v", ids)
+	}
+	if ids := event.TaggedEntries("Hits"); !reflect.DeepEqual(ids, []uint64{1, 9}) {
+		t.Errorf("Got Hits entries %v", ids)
+	}
+	if part := event.GetEntry(2).(*example.Particle); !reflect.DeepEqual(part.Child, []uint64{1}) {
+		t.Errorf("Got entry 2 %v", part)
+	}
+	if part := event.GetEntry(3).(*example.Particle); part.Pdg != 25 || !reflect.DeepEqual(part.Child, []uint64{4, 5}) {
+		t.Errorf("Got entry 3 %v", part)
+	}
+	if part := event.GetEntry(7).(*example.Particle); !reflect.DeepEqual(part.Parent, []uint64{5}) {
+		t.Errorf("Got entry 7 %v", part)
+	}
+	hit := event.GetEntry(9).(*prolcio.SimCalorimeterHit)
+	if len(hit.Contributions) != 3 || hit.Contributions[0].MCParticle != 6 || hit.Contributions[1].MCParticle != 7 {
+		t.Errorf("Got entry 9 %v", hit)
+	}
+	if string(event.Metadata["run"]) != "\x01" || string(event.Metadata["sample"]) != "background" {
+		t.Errorf("Got metadata %v", event.Metadata)
+	}
+
+	if part := src.GetEntry(3).(*example.Particle); !reflect.DeepEqual(part.Parent, []uint64{1}) {
+		t.Errorf("Merge changed source entry to %v", part)
+	}
+}
+
+func TestMergeEventDanglingTag(t *testing.T) {
+	dst := NewEvent()
+	dst.AddEntry("Hits", &prolcio.SimCalorimeterHit{})
+
+	src := NewEvent()
+	src.AddEntry("MC", &example.Particle{Pdg: 11})
+	src.TagEntry(42, "Ghost")
+	src.TagEntry(1, "Ghost")
+
+	if _, _, err := MergeEvent(dst, src, NullDangling); err != nil {
+		t.Fatal(err)
+	}
+	if tagged := dst.proto.Tag["Ghost"].Entry; !reflect.DeepEqual(tagged, []uint64{2}) {
+		t.Errorf("Got Ghost entries %v", tagged)
+	}
+}
@@ -0,0 +1,171 @@
+package proio
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeRotateTestFiles(pattern string, maxBytes int64, maxEvents uint64, nWorkers int) error {
+	writer, err := CreateRotating(pattern, maxBytes, maxEvents)
+	if err != nil {
+		return err
+	}
+	writer.BucketDumpThres = 0x100
+	writer.WriteIndex = true
+	if err := writer.SetConcurrency(nWorkers); err != nil {
+		return err
+	}
+
+	if err := pushTestEvents(writer, 0, 100, 0); err != nil {
+		return err
+	}
+	return writer.Close()
+}
+
+// checkRotateTestFile reads a file on its own and returns the number of
+// events in it.
+func checkRotateTestFile(filename string, firstEvent int, t *testing.T) int {
+	reader, err := Open(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	nEvents, err := reader.EventCount()
+	if err != nil {
+		t.Error(err)
+	}
+
+	i := firstEvent
+	for event := reader.Next(); event != nil; event = reader.Next() {
+		checkIndexTestEvent(event, i, t)
+		// the second descriptor is only written with event 20
+		nFDs := len(reader.BucketHeader.FileDescriptor)
+		if i == firstEvent && (nFDs == 0 || firstEvent >= 20 && nFDs != 2) {
+			t.Errorf("%v starts with %v FileDescriptorProtos", filename, nFDs)
+		}
+		i++
+	}
+	if uint64(i-firstEvent) != nEvents {
+		t.Errorf("Read %v events from %v with an index of %v events", i-firstEvent, filename, nEvents)
+	}
+	return i - firstEvent
+}
+
+func TestRotateEvents(t *testing.T) {
+	for _, nWorkers := range []int{0, 3} {
+		tmpDir, err := ioutil.TempDir("", "proiotest")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(tmpDir)
+
+		pattern := filepath.Join(tmpDir, "run%02d.proio")
+		if err := writeRotateTestFiles(pattern, 0, 30, nWorkers); err != nil {
+			t.Fatal(err)
+		}
+
+		for i, expected := range []int{30, 30, 30, 10} {
+			filename := fmt.Sprintf(pattern, i)
+			if n := checkRotateTestFile(filename, i*30, t); n != expected {
+				t.Errorf("%v has %v events instead of %v", filename, n, expected)
+			}
+		}
+		if _, err := os.Stat(fmt.Sprintf(pattern, 4)); err == nil {
+			t.Errorf("Extra file written")
+		}
+	}
+}
+
+func TestRotateBytes(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "proiotest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	const maxBytes = 0x1000
+	pattern := filepath.Join(tmpDir, "run%02d.proio")
+	if err := writeRotateTestFiles(pattern, maxBytes, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	filenames, _ := filepath.Glob(filepath.Join(tmpDir, "run*.proio"))
+	if len(filenames) < 2 {
+		t.Fatalf("Output not split: %v", filenames)
+	}
+
+	nEvents := 0
+	for _, filename := range filenames {
+		reader, err := Open(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		index, err := reader.Index()
+		if err != nil {
+			t.Fatal(err)
+		}
+		last := index.Buckets[len(index.Buckets)-1]
+		header, err := reader.BucketHeaderAt(last)
+		if err != nil {
+			t.Fatal(err)
+		}
+		end := last.Offset + int64(len(magicBytes)+4+header.Size()) + int64(header.BucketSize)
+		if end > maxBytes {
+			t.Errorf("Buckets of %v end at %v", filename, end)
+		}
+		reader.Close()
+
+		nEvents += checkRotateTestFile(filename, nEvents, t)
+	}
+	if nEvents != 100 {
+		t.Errorf("Read %v events instead of %v", nEvents, 100)
+	}
+}
+
+func TestRotateBadPattern(t *testing.T) {
+	if _, err := CreateRotating("run%s%d.proio", 0, 10); err == nil {
+		t.Errorf("No error for bad filename pattern")
+	}
+}
+
+func TestRotateForced(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "proiotest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pattern := filepath.Join(tmpDir, "run%02d.proio")
+	writer, err := CreateRotating(pattern, 0, 15)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, events := range [][2]int{{0, 20}, {20, 20}, {40, 10}} {
+		if err := writer.Rotate(); err != nil {
+			t.Error(err)
+		}
+		if err := pushTestEvents(writer, events[0], events[1], 0); err != nil {
+			t.Error(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Error(err)
+	}
+
+	firstEvent := 0
+	for i, expected := range []int{15, 5, 15, 5, 10} {
+		filename := fmt.Sprintf(pattern, i)
+		if n := checkRotateTestFile(filename, firstEvent, t); n != expected {
+			t.Errorf("%v has %v events instead of %v", filename, n, expected)
+		}
+		firstEvent += expected
+	}
+
+	if err := NewWriter(ioutil.Discard).Rotate(); err == nil {
+		t.Errorf("No error for rotating a Writer without files")
+	}
+}
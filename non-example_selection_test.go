@@ -0,0 +1,142 @@
+package proio
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/proio-org/go-proio-pb/model/example"
+)
+
+func selectionTestEvent() *Event {
+	event := NewEvent()
+	event.AddEntry("Particle", &example.Particle{Pdg: 11, Charge: -3, P: &example.XYZF{Z: 10}})
+	event.AddEntry("Particle", &example.Particle{Pdg: -11, Charge: 3, Child: []uint64{1, 3}})
+	id := event.AddEntry("Particle", &example.Particle{Pdg: 11, Charge: -3, P: &example.XYZF{Z: -2}})
+	event.TagEntry(id, "Truth")
+	event.AddEntry("Particle", &example.Particle{Pdg: 22})
+	return event
+}
+
+func TestSelectionEntries(t *testing.T) {
+	event := selectionTestEvent()
+
+	tests := []struct {
+		expr string
+		ids  []uint64
+	}{
+		{"Particle.pdg == 11", []uint64{1, 3}},
+		{"Particle.pdg == 11 && Truth", []uint64{3}},
+		{"Particle.pdg == 11 & !Truth", []uint64{1}},
+		{"Particle.pdg == -11 || Particle.pdg == 22", []uint64{2, 4}},
+		{"Particle.p.z > 0", []uint64{1}},
+		{"Particle.p.z <= -2", []uint64{3}},
+		{"Particle.child == 3", []uint64{2}},
+		{"Particle.charge", []uint64{1, 2, 3}},
+		{"!(Particle.charge | Truth)", []uint64{4}},
+		{"`Truth`", []uint64{3}},
+		{"Particle.pdg != 11 && count(Truth) == 1", []uint64{2, 4}},
+		{"Particle.mass >= 1e-3", nil},
+	}
+
+	for _, test := range tests {
+		sel, err := ParseSelection(test.expr)
+		if err != nil {
+			t.Errorf("%v: %v", test.expr, err)
+			continue
+		}
+		ids, err := sel.SelectEntries(event)
+		if err != nil {
+			t.Errorf("%v: %v", test.expr, err)
+			continue
+		}
+		if !reflect.DeepEqual(ids, test.ids) {
+			t.Errorf("%v selected %v instead of %v", test.expr, ids, test.ids)
+		}
+	}
+}
+
+func TestSelectionEvents(t *testing.T) {
+	event := selectionTestEvent()
+
+	tests := []struct {
+		expr  string
+		match bool
+	}{
+		{"count(Particle.pdg == 11) >= 2", true},
+		{"count(Particle.pdg == 11 && Truth) >= 2", false},
+		{"Particle.pdg == 22 && Truth", true},
+		{"Particle.pdg == 13", false},
+		{"!Missing && Particle", true},
+		{"count(Particle) == 4 && count(Missing) == 0", true},
+	}
+
+	for _, test := range tests {
+		sel, err := ParseSelection(test.expr)
+		if err != nil {
+			t.Errorf("%v: %v", test.expr, err)
+			continue
+		}
+		match, err := sel.MatchEvent(event)
+		if err != nil {
+			t.Errorf("%v: %v", test.expr, err)
+		}
+		if match != test.match {
+			t.Errorf("%v matched %v", test.expr, match)
+		}
+	}
+}
+
+func TestSelectionUnlinkedType(t *testing.T) {
+	// energy = 2.5, particle = {pdg: 11}
+	wireData := []byte{0x0d, 0x00, 0x00, 0x20, 0x40, 0x12, 0x02, 0x18, 0x16}
+
+	buffer := &bytes.Buffer{}
+	writer := NewWriter(buffer)
+	event := NewEvent()
+	if _, err := event.AddSerializedEntry("Unlinked", wireData, "proio.test.Unlinked", unlinkedDescriptor()); err != nil {
+		t.Fatal(err)
+	}
+	writer.Push(event)
+	writer.Close()
+
+	reader := NewReader(buffer)
+	event = reader.Next()
+	if event == nil {
+		t.Fatal(reader.Err)
+	}
+
+	sel, err := ParseSelection("Unlinked.energy > 2 && Unlinked.particle.pdg == 11")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if match, err := sel.MatchEntry(event, 1); !match || err != nil {
+		t.Errorf("Unlinked entry matched %v with error %v", match, err)
+	}
+}
+
+func TestSelectionErrors(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"Particle.pdg ==",
+		"(Truth",
+		"Truth Particle",
+		"Truth == 1",
+		"count(Truth",
+		"Particle.",
+		"\"unterminated",
+		"Particle.pdg # 11",
+	} {
+		if _, err := ParseSelection(expr); err == nil {
+			t.Errorf("No error parsing %q", expr)
+		}
+	}
+
+	sel, err := ParseSelection("Particle.nonexistent == 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sel.MatchEvent(selectionTestEvent()); err == nil {
+		t.Errorf("No error selecting on missing field")
+	}
+}
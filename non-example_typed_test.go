@@ -0,0 +1,58 @@
+package proio
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/proio-org/go-proio-pb/model/example"
+	prolcio "github.com/proio-org/go-proio-pb/model/lcio"
+)
+
+func TestEntriesOfType(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	writer := NewWriter(buffer)
+	event := NewEvent()
+	event.AddEntry("A", &example.Particle{Pdg: 1})
+	event.AddEntry("B", &prolcio.MCParticle{PDG: 2})
+	event.AddEntry("C", &example.Particle{Pdg: 3})
+	event.AddEntry("A", &prolcio.MCParticle{PDG: 4})
+	writer.Push(event)
+	writer.Close()
+
+	reader := NewReader(buffer)
+	event = reader.Next()
+	if event == nil {
+		t.Fatal(reader.Err)
+	}
+
+	if ids := event.EntriesOfType("proio.model.lcio.MCParticle"); !reflect.DeepEqual(ids, []uint64{2, 4}) {
+		t.Errorf("Got MCParticle IDs %v", ids)
+	}
+	if ids := event.EntriesOfMessageType(&example.Particle{}); !reflect.DeepEqual(ids, []uint64{1, 3}) {
+		t.Errorf("Got Particle IDs %v", ids)
+	}
+	if ids := event.EntriesOfType("proio.model.lcio.Track"); ids != nil {
+		t.Errorf("Got Track IDs %v", ids)
+	}
+
+	mcParts := []*prolcio.MCParticle{{PDG: 0}}
+	ids, err := event.GetEntriesOfType(&mcParts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ids, []uint64{2, 4}) {
+		t.Errorf("Got MCParticle IDs %v", ids)
+	}
+	if len(mcParts) != 3 || mcParts[1].PDG != 2 || mcParts[2].PDG != 4 {
+		t.Errorf("Got MCParticles %v", mcParts)
+	}
+
+	var notMsgs []int
+	if _, err := event.GetEntriesOfType(&notMsgs); err == nil {
+		t.Errorf("No error for slice of non-messages")
+	}
+	if _, err := event.GetEntriesOfType(mcParts); err == nil {
+		t.Errorf("No error for slice instead of pointer")
+	}
+}
@@ -17,7 +17,7 @@ func TestCompSwitch(t *testing.T) {
 	writer := NewWriter(buffer)
 	writer.SetCompression(UNCOMPRESSED)
 
-	var eventsOut [7]*Event
+	var eventsOut [9]*Event
 
 	event := NewEvent()
 	event.AddEntries(
@@ -94,11 +94,32 @@ func TestCompSwitch(t *testing.T) {
 	writer.Push(event)
 	eventsOut[6] = event
 
+	writer.SetCompression(ZSTD)
+
+	event = NewEvent()
+	event.AddEntries(
+		"Baz",
+		&prolcio.SimTrackerHit{},
+		&prolcio.SimTrackerHit{},
+	)
+	writer.Push(event)
+	eventsOut[7] = event
+
+	writer.SetCompression(LZ4)
+
+	event = NewEvent()
+	event.AddEntries(
+		"Qux",
+		&prolcio.SimTrackerHit{},
+	)
+	writer.Push(event)
+	eventsOut[8] = event
+
 	writer.Close()
 
 	reader := NewReader(buffer)
 
-	for i := 0; i < 7; i++ {
+	for i := 0; i < 9; i++ {
 		event := reader.Next()
 		if reader.Err != nil {
 			t.Error(reader.Err)
@@ -204,6 +225,30 @@ func TestLZMAPushSkipGet2(t *testing.T) {
 	eventPushSkipGet2(LZMA, t)
 }
 
+func TestZSTDPushGet1(t *testing.T) {
+	eventPushGet1(ZSTD, t)
+}
+
+func TestZSTDPushGet2(t *testing.T) {
+	eventPushGet2(ZSTD, t)
+}
+
+func TestZSTDPushGet3(t *testing.T) {
+	eventPushGet3(ZSTD, t)
+}
+
+func TestZSTDPushGet4(t *testing.T) {
+	eventPushGet4(ZSTD, t)
+}
+
+func TestZSTDPushSkipGet1(t *testing.T) {
+	eventPushSkipGet1(ZSTD, t)
+}
+
+func TestZSTDPushSkipGet2(t *testing.T) {
+	eventPushSkipGet2(ZSTD, t)
+}
+
 func eventPushGet1(comp Compression, t *testing.T) {
 	buffer := &bytes.Buffer{}
 	writer := NewWriter(buffer)
@@ -498,6 +543,10 @@ func TestWriteLZMAIterateFile(t *testing.T) {
 	writeIterateFile(LZMA, t)
 }
 
+func TestWriteZSTDIterateFile(t *testing.T) {
+	writeIterateFile(ZSTD, t)
+}
+
 func TestWriteUncompIterateFile(t *testing.T) {
 	writeIterateFile(UNCOMPRESSED, t)
 }
@@ -989,3 +1038,37 @@ waitLoop:
 		t.Errorf("Failed to stop scans")
 	}
 }
+
+func TestWriteDeterministic(t *testing.T) {
+	var streams [2][]byte
+	for i := range streams {
+		buffer := &bytes.Buffer{}
+		writer := NewWriter(buffer)
+		writer.SetCompression(UNCOMPRESSED)
+		writer.PushMetadata("key1", []byte("value1"))
+		writer.PushMetadata("key2", []byte("value2"))
+		writer.PushMetadata("key3", []byte("value3"))
+
+		event := NewEvent()
+		event.AddEntries(
+			"MCParticles",
+			&prolcio.MCParticle{},
+			&prolcio.MCParticle{},
+			&prolcio.MCParticle{},
+		)
+		event.AddEntries(
+			"TrackerHits",
+			&prolcio.SimTrackerHit{},
+			&prolcio.SimTrackerHit{},
+		)
+		event.TagEntry(1, "Truth")
+		writer.Push(event)
+		writer.Close()
+
+		streams[i] = buffer.Bytes()
+	}
+
+	if !bytes.Equal(streams[0], streams[1]) {
+		t.Errorf("Equal events written as different streams")
+	}
+}
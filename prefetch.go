@@ -0,0 +1,194 @@
+package proio
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+
+	proto "github.com/proio-org/go-proio-pb"
+)
+
+// SetConcurrency sets the number of worker goroutines that decompress buckets
+// ahead of the consumer of the Reader.  When nWorkers is greater than zero,
+// the Reader reads upcoming buckets from the stream in a separate goroutine
+// and hands them to the workers, while events are still delivered in stream
+// order.  A value of zero (the default) reads and decompresses buckets
+// synchronously as events are requested.  SetConcurrency should be called
+// before reading from a stream that is not seekable, since buckets that have
+// been read ahead are otherwise lost.
+func (rdr *Reader) SetConcurrency(nWorkers int) {
+	if nWorkers < 0 {
+		nWorkers = 0
+	}
+	if nWorkers != rdr.nWorkers {
+		rdr.stopPrefetch(true)
+		rdr.nWorkers = nWorkers
+	}
+}
+
+type prefetchedBucket struct {
+	header    *proto.BucketHeader
+	nSkipped  int
+	offset    int64
+	endOffset int64
+	raw       []byte
+	data      []byte
+	err       error
+	ready     chan struct{}
+}
+
+type prefetcher struct {
+	buckets chan *prefetchedBucket
+	quit    chan struct{}
+	done    chan struct{}
+}
+
+func (rdr *Reader) startPrefetch() {
+	pf := &prefetcher{
+		buckets: make(chan *prefetchedBucket, 2*rdr.nWorkers),
+		quit:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	rdr.prefetch = pf
+	rdr.resumeOffset = rdr.streamOffset
+
+	jobs := make(chan *prefetchedBucket)
+	for i := 0; i < rdr.nWorkers; i++ {
+		go decompressWorker(jobs)
+	}
+
+	go func() {
+		defer close(pf.done)
+		defer close(pf.buckets)
+		defer close(jobs)
+
+		for {
+			bucket := &prefetchedBucket{ready: make(chan struct{})}
+
+			var err, readErr error
+			decompressed := false
+			bucket.header, bucket.offset, bucket.nSkipped, err = rdr.readBareHeader()
+			if err == nil {
+				dataOffset := rdr.streamOffset
+				bucket.raw = make([]byte, bucket.header.BucketSize)
+				readErr = rdr.readStream(bucket.raw)
+
+				// a bucket that contains a magic number may have a damaged
+				// size that covers other buckets, so it is decompressed
+				// right away in order to look for them if it is damaged
+				if readErr == nil && bytes.Contains(bucket.raw, magicBytes[:]) {
+					bucket.data, readErr = decompressBucket(bucket, nil)
+					decompressed = true
+				}
+				if readErr != nil {
+					nRead := rdr.streamOffset - dataOffset
+					if err := rdr.rewindStream(dataOffset, bucket.raw[:nRead]); err != nil {
+						readErr = err
+						bucket.header = nil
+					}
+				}
+				if readErr != nil || decompressed {
+					bucket.raw = nil
+				}
+			}
+			bucket.endOffset = rdr.streamOffset
+
+			if err != nil || readErr != nil || decompressed {
+				if err != nil {
+					bucket.header = nil
+					bucket.err = err
+				} else {
+					bucket.err = readErr
+				}
+				close(bucket.ready)
+			} else {
+				select {
+				case jobs <- bucket:
+				case <-pf.quit:
+					return
+				}
+			}
+
+			select {
+			case pf.buckets <- bucket:
+			case <-pf.quit:
+				return
+			}
+
+			if err == io.EOF || err == io.ErrUnexpectedEOF || bucket.header == nil && readErr != nil {
+				return
+			}
+		}
+	}()
+}
+
+func decompressWorker(jobs <-chan *prefetchedBucket) {
+	var decompressor io.Reader
+	for bucket := range jobs {
+		bucket.data, bucket.err = decompressBucket(bucket, &decompressor)
+		bucket.raw = nil
+		close(bucket.ready)
+	}
+}
+
+// decompressBucket verifies and decompresses the raw bytes of a bucket.  If
+// decompressor is not nil, it is used to reuse a decompressor between
+// buckets.
+func decompressBucket(bucket *prefetchedBucket, decompressor *io.Reader) ([]byte, error) {
+	if err := verifyBucketChecksum(bucket.header, bucket.raw, bucket.offset); err != nil {
+		return nil, err
+	}
+	var prev io.Reader
+	if decompressor != nil {
+		prev = *decompressor
+	}
+	bucketReader, err := bucketDecompressor(bucket.header.Compression, bytes.NewReader(bucket.raw), prev)
+	if decompressor != nil {
+		*decompressor = bucketReader
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(bucketReader)
+}
+
+// readPrefetchedHeader takes the next bucket from the prefetch pipeline,
+// starting the pipeline if necessary.
+func (rdr *Reader) readPrefetchedHeader() (*proto.BucketHeader, int, error) {
+	if rdr.prefetch == nil {
+		rdr.startPrefetch()
+	}
+
+	bucket, ok := <-rdr.prefetch.buckets
+	if !ok {
+		rdr.bucketOffset = rdr.resumeOffset
+		return nil, 0, io.EOF
+	}
+	rdr.resumeOffset = bucket.endOffset
+	rdr.bucketOffset = bucket.offset
+
+	if bucket.header == nil {
+		return nil, bucket.nSkipped, bucket.err
+	}
+	rdr.prefetched = bucket
+	return bucket.header, bucket.nSkipped, nil
+}
+
+// stopPrefetch stops the prefetch pipeline and waits for it to release the
+// stream, so that the stream can be read, switched or closed afterwards.  If
+// resume is true, this also seeks the stream back, if possible, to the first
+// bucket that has not been consumed.
+func (rdr *Reader) stopPrefetch(resume bool) {
+	if rdr.prefetch == nil {
+		return
+	}
+
+	close(rdr.prefetch.quit)
+	<-rdr.prefetch.done
+	if resume {
+		if _, ok := rdr.streamReader.(io.Seeker); ok {
+			rdr.seekStream(rdr.resumeOffset)
+		}
+	}
+	rdr.prefetch = nil
+}
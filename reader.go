@@ -6,9 +6,11 @@ import (
 	"encoding/binary"
 	"errors"
 	"io"
+	"io/ioutil"
 	"os"
 	"sync"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4"
 	proto "github.com/proio-org/go-proio-pb"
 	"github.com/smira/lzma"
@@ -23,10 +25,28 @@ type Reader struct {
 	Err          error
 
 	streamReader          io.Reader
+	streamOffset          int64
+	rescan                []byte
+	sourceName            string
+	sources               []chainSource
+	closeSource           func()
+	interruptRead         func()
+	bucketOffset          int64
+	bucketDataOffset      int64
+	index                 *Index
 	bucket                *bytes.Reader
 	bucketReader          io.Reader
 	bucketEventsRead      uint64
 	bucketIndex           uint64
+	nWorkers              int
+	prefetch              *prefetcher
+	prefetched            *prefetchedBucket
+	resumeOffset          int64
+	damagePolicy          DamagePolicy
+	damageHandler         func(*DamageReport)
+	damageCounts          DamageCounts
+	damagedBucket         *DamageReport
+	stoppedErr            error
 	deferredUntilStopScan []func()
 	deferredUntilClose    []func()
 
@@ -44,6 +64,7 @@ func Open(filename string) (*Reader, error) {
 	}
 
 	reader := NewReader(file)
+	reader.sourceName = filename
 	reader.DeferUntilClose(func() { file.Close() })
 	return reader, nil
 }
@@ -58,14 +79,31 @@ func NewReader(streamReader io.Reader) *Reader {
 		bucketReader: &bytes.Buffer{},
 	}
 
+	if seeker, ok := streamReader.(io.Seeker); ok {
+		rdr.streamOffset, _ = seeker.Seek(0, 1 /*io.SeekCurrent*/)
+	}
+
 	return rdr
 }
 
 // Close closes any file that was opened by the library, and stops any
 // unfinished scans.  Close does not close io.Readers passed directly to
-// NewReader.
+// NewReader.  Close may be called from another goroutine while events are
+// scanned with ScanEvents, in which case it waits for the scan to finish
+// reading its current event.
 func (rdr *Reader) Close() {
+	if rdr.interruptRead != nil {
+		rdr.interruptRead()
+	}
 	rdr.StopScan()
+
+	rdr.Lock()
+	defer rdr.Unlock()
+	rdr.stopPrefetch(false)
+	if rdr.closeSource != nil {
+		rdr.closeSource()
+		rdr.closeSource = nil
+	}
 	for _, thisFunc := range rdr.deferredUntilClose {
 		thisFunc()
 	}
@@ -73,25 +111,46 @@ func (rdr *Reader) Close() {
 }
 
 // Next retrieves the next event from the stream.  The Reader's Err member is
-// assigned the error status of this call.
+// assigned the error status of this call.  Damaged regions of the stream are
+// handled according to the Reader's DamagePolicy.
 func (rdr *Reader) Next() *Event {
-	var event *Event
+	for {
+		event, err := rdr.nextEvent()
+		if event != nil || err != nil {
+			rdr.Err = err
+			return event
+		}
+	}
+}
 
+// nextEvent reads the next event from the stream.  Both the event and the
+// error are nil if a damaged region was skipped.
+func (rdr *Reader) nextEvent() (*Event, error) {
 	// use Skip() to ensure that we land on a non-empty bucket
-	if _, rdr.Err = rdr.Skip(0); rdr.Err == nil {
-		if rdr.bucket.Size() == 0 {
-			rdr.readBucket()
-		}
+	if _, err := rdr.Skip(0); err != nil {
+		return nil, err
+	}
 
-		event, rdr.Err = rdr.readFromBucket()
+	if rdr.bucket.Size() == 0 {
+		if err := rdr.readBucket(); err != nil {
+			return nil, rdr.bucketDamaged(err)
+		}
 	}
 
-	return event
+	event, err := rdr.readFromBucket()
+	if err != nil {
+		return nil, rdr.bucketDamaged(err)
+	}
+	return event, nil
 }
 
 // Skip skips nEvents events.  If the return error is nil, nEvents have been
 // skipped.
 func (rdr *Reader) Skip(nEvents uint64) (nSkipped uint64, err error) {
+	if rdr.stoppedErr != nil {
+		return 0, rdr.stoppedErr
+	}
+
 	startIndex := rdr.bucketIndex
 	rdr.bucketIndex += nEvents
 
@@ -105,13 +164,16 @@ func (rdr *Reader) Skip(nEvents uint64) (nSkipped uint64, err error) {
 
 			// skip the bucket bytes on the stream if they haven't been read
 			// into memory already
-			if nBucketEvents > 0 && rdr.bucket.Size() == 0 {
+			if nBucketEvents > 0 && rdr.bucket.Size() == 0 && rdr.prefetched == nil {
 				seeker, ok := rdr.streamReader.(io.Seeker)
 				if ok {
-					seekBytes(seeker, int64(rdr.BucketHeader.BucketSize))
+					if err = seekBytes(seeker, int64(rdr.BucketHeader.BucketSize)); err != nil {
+						return
+					}
+					rdr.streamOffset += int64(rdr.BucketHeader.BucketSize)
 				} else {
 					bucketBytes := make([]byte, rdr.BucketHeader.BucketSize)
-					if err = readBytes(rdr.streamReader, bucketBytes); err != nil {
+					if err = rdr.readStream(bucketBytes); err != nil {
 						return
 					}
 				}
@@ -136,6 +198,7 @@ func (rdr *Reader) SeekToStart() error {
 	if !ok {
 		return errors.New("stream not seekable")
 	}
+	rdr.stopPrefetch(true)
 
 	for {
 		n, err := seeker.Seek(0, 0 /*io.SeekStart*/)
@@ -147,8 +210,12 @@ func (rdr *Reader) SeekToStart() error {
 		}
 	}
 
+	rdr.streamOffset = 0
+	rdr.rescan = nil
+	rdr.damagedBucket = nil
 	rdr.Metadata = make(map[string][]byte)
 	rdr.bucketIndex = 0
+	rdr.stoppedErr = nil
 	if err := rdr.readHeader(); err != nil {
 		return err
 	}
@@ -248,108 +315,254 @@ func (rdr *Reader) readHeader() (err error) {
 	rdr.bucketEventsRead = 0
 	rdr.BucketHeader = nil
 	rdr.bucket = &bytes.Reader{}
+	rdr.prefetched = nil
+
+	var nSkipped int
+	var bucketHeader *proto.BucketHeader
+	for {
+		if rdr.nWorkers > 0 {
+			bucketHeader, nSkipped, err = rdr.readPrefetchedHeader()
+		} else {
+			bucketHeader, rdr.bucketOffset, nSkipped, err = rdr.readBareHeader()
+			rdr.bucketDataOffset = rdr.streamOffset
+		}
+		regionStart := rdr.bucketOffset - int64(nSkipped)
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			if rdr.damagedBucket != nil || rdr.consumedOffset() > regionStart {
+				// the stream ends with bytes that are not a complete bucket
+				if err = rdr.regionDamaged(regionStart, rdr.consumedOffset(), io.ErrUnexpectedEOF); err != nil {
+					return
+				}
+			}
 
+			// continue with the next source of a chain at the end of a stream
+			if len(rdr.sources) == 0 {
+				return io.EOF
+			}
+			if err = rdr.nextSource(); err != nil {
+				return
+			}
+			continue
+		}
+
+		if err != nil && rdr.consumedOffset() > rdr.bucketOffset {
+			// the header following a magic number is damaged
+			if err = rdr.regionDamaged(regionStart, rdr.consumedOffset(), err); err != nil {
+				return
+			}
+			continue
+		}
+		break
+	}
+	if err != nil {
+		return
+	}
+	rdr.BucketHeader = bucketHeader
+
+	if err = rdr.applyHeader(bucketHeader); err != nil {
+		return
+	}
+
+	if rdr.damagedBucket != nil || nSkipped > 0 {
+		return rdr.regionDamaged(rdr.bucketOffset-int64(nSkipped), rdr.bucketOffset, ErrResynchronized)
+	}
+	return
+}
+
+// readBareHeader synchronizes the stream to the next magic number and reads
+// the bucket header that follows it, without altering the state of the
+// Reader beyond the stream position.  The offset of the magic number is
+// returned, or the offset of the end of the stream if there is none, along
+// with the number of bytes that were skipped before it (see syncToMagic).
+func (rdr *Reader) readBareHeader() (bucketHeader *proto.BucketHeader, offset int64, nSkipped int, err error) {
 	// Find and read magic bytes for synchronization
-	var n int
-	n, err = rdr.syncToMagic()
+	nSkipped, err = rdr.syncToMagic()
+	offset = rdr.streamOffset
 	if err != nil {
 		return
 	}
+	offset -= int64(len(magicBytes))
 
 	// Read header size and then header
 	headerSizeBuf := make([]byte, 4)
-	if err = readBytes(rdr.streamReader, headerSizeBuf); err != nil {
+	if err = rdr.readStream(headerSizeBuf); err != nil {
 		return
 	}
 	headerSize := binary.LittleEndian.Uint32(headerSizeBuf)
 
 	headerBuf := make([]byte, headerSize)
-	if err = readBytes(rdr.streamReader, headerBuf); err != nil {
+	if err = rdr.readStream(headerBuf); err != nil {
 		return
 	}
-	bucketHeader := &proto.BucketHeader{}
+	bucketHeader = &proto.BucketHeader{}
 	if err = bucketHeader.Unmarshal(headerBuf); err != nil {
-		return
+		bucketHeader = nil
 	}
-	rdr.BucketHeader = bucketHeader
+	return
+}
 
+// applyHeader updates the Reader's metadata and the descriptor pool with the
+// contents of a bucket header.
+func (rdr *Reader) applyHeader(bucketHeader *proto.BucketHeader) error {
 	// Set metadata for future events
-	for key, bytes := range rdr.BucketHeader.Metadata {
+	for key, bytes := range bucketHeader.Metadata {
 		rdr.Metadata[key] = bytes
 	}
 
 	// Add descriptors to pool
-	for _, fdBytes := range rdr.BucketHeader.FileDescriptor {
-		if err = addFDFromBytes(fdBytes); err != nil {
-			return
+	for _, fdBytes := range bucketHeader.FileDescriptor {
+		if err := addFDFromBytes(fdBytes); err != nil {
+			return err
 		}
 	}
 
-	if n != len(magicBytes) {
-		return errors.New("stream resynchronized")
-	}
-	return
+	return nil
 }
 
 func (rdr *Reader) readBucket() (err error) {
-	// read bucket bytes
-	bucketBytes := make([]byte, rdr.BucketHeader.BucketSize)
-	if err = readBytes(rdr.streamReader, bucketBytes); err != nil {
+	// use bucket already read and decompressed by the prefetch pipeline
+	if rdr.prefetched != nil {
+		<-rdr.prefetched.ready
+		if err = rdr.prefetched.err; err != nil {
+			return
+		}
+		rdr.bucket.Reset(rdr.prefetched.data)
+		rdr.bucketReader = rdr.bucket
 		return
 	}
+
+	// read bucket bytes, marking them as read even if the stream ends early
+	bucketBytes := make([]byte, rdr.BucketHeader.BucketSize)
+	err = rdr.readStream(bucketBytes)
 	rdr.bucket.Reset(bucketBytes)
+	if err != nil {
+		return
+	}
+	if err = verifyBucketChecksum(rdr.BucketHeader, bucketBytes, rdr.bucketOffset); err != nil {
+		return
+	}
 
 	// Set up decompression for bucket
-	switch rdr.BucketHeader.Compression {
+	rdr.bucketReader, err = bucketDecompressor(rdr.BucketHeader.Compression, rdr.bucket, rdr.bucketReader)
+
+	return
+}
+
+// bucketDecompressor returns an io.Reader that decompresses the bucket bytes
+// in src according to comp.  If prev is a decompressor of the right kind from
+// a previous bucket, it is reset and reused.
+func bucketDecompressor(comp proto.BucketHeader_CompType, src *bytes.Reader, prev io.Reader) (io.Reader, error) {
+	switch comp {
 	case proto.BucketHeader_GZIP:
-		gzipRdr, ok := rdr.bucketReader.(*gzip.Reader)
+		gzipRdr, ok := prev.(*gzip.Reader)
 		if ok {
-			gzipRdr.Reset(rdr.bucket)
+			gzipRdr.Reset(src)
 		} else {
-			gzipRdr, err = gzip.NewReader(rdr.bucket)
+			var err error
+			gzipRdr, err = gzip.NewReader(src)
 			if err != nil {
-				return
+				return prev, err
 			}
 		}
-		rdr.bucketReader = gzipRdr
+		return gzipRdr, nil
 	case proto.BucketHeader_LZ4:
-		lz4Rdr, ok := rdr.bucketReader.(*lz4.Reader)
+		lz4Rdr, ok := prev.(*lz4.Reader)
 		if ok {
-			lz4Rdr.Reset(rdr.bucket)
+			lz4Rdr.Reset(src)
 		} else {
-			lz4Rdr = lz4.NewReader(rdr.bucket)
+			lz4Rdr = lz4.NewReader(src)
 		}
-		rdr.bucketReader = lz4Rdr
+		return lz4Rdr, nil
 	case proto.BucketHeader_LZMA:
-		lzmaRdr := lzma.NewReader(rdr.bucket)
-		rdr.bucketReader = lzmaRdr
+		return lzma.NewReader(src), nil
+	case proto.BucketHeader_ZSTD:
+		compBytes, err := ioutil.ReadAll(src)
+		if err != nil {
+			return prev, err
+		}
+		decoder, err := sharedZstdDecoder()
+		if err != nil {
+			return prev, err
+		}
+		bucketBytes, err := decoder.DecodeAll(compBytes, nil)
+		if err != nil {
+			return prev, err
+		}
+		return bytes.NewReader(bucketBytes), nil
 	case proto.BucketHeader_NONE:
-		rdr.bucketReader = rdr.bucket
-	default:
-		err = errors.New("unknown bucket compression type")
+		return src, nil
 	}
+	return prev, errors.New("unknown bucket compression type")
+}
 
-	return
+var (
+	zstdDecoder     *zstd.Decoder
+	zstdDecoderErr  error
+	zstdDecoderOnce sync.Once
+)
+
+// sharedZstdDecoder returns a Zstandard decoder that is shared by all
+// Readers, since stateless decoding with DecodeAll is safe for concurrent
+// use.  The decoder is created on first use, so that programs that never read
+// ZSTD buckets do not pay for its goroutines and buffers.
+func sharedZstdDecoder() (*zstd.Decoder, error) {
+	zstdDecoderOnce.Do(func() {
+		zstdDecoder, zstdDecoderErr = zstd.NewReader(nil)
+	})
+	return zstdDecoder, zstdDecoderErr
 }
 
+// syncToMagic consumes the stream up to and including the next magic number.
+// The number of bytes that were skipped before the magic number, or before
+// the end of the stream, is returned.  Skipped bytes that are exactly an
+// index footer, as found at the end of a stream and between concatenated
+// streams, are not counted.
 func (rdr *Reader) syncToMagic() (int, error) {
 	magicByteBuf := make([]byte, 1)
 	nRead := 0
-	for {
-		err := readBytes(rdr.streamReader, magicByteBuf)
-		if err != nil {
-			return nRead, err
+
+	// keep the last bytes read in order to recognize an index footer
+	footerLen := 4 + len(indexMagicBytes)
+	keepLen := footerLen + len(magicBytes)
+	tail := make([]byte, 0, 2*keepLen)
+	readByte := func() error {
+		if err := rdr.readStream(magicByteBuf); err != nil {
+			return err
 		}
 		nRead++
+		if len(tail) == cap(tail) {
+			tail = append(tail[:0], tail[len(tail)-keepLen:]...)
+		}
+		tail = append(tail, magicByteBuf[0])
+		return nil
+	}
+
+	// skipped returns the number of bytes skipped before the last nAfter
+	// bytes that were read
+	skipped := func(nAfter int) int {
+		nSkipped := nRead - nAfter
+		if len(tail) >= nAfter+footerLen {
+			footer := tail[len(tail)-nAfter-footerLen : len(tail)-nAfter]
+			indexSize := int(binary.LittleEndian.Uint32(footer[:4]))
+			if bytes.Equal(footer[4:], indexMagicBytes[:]) && indexSize+footerLen == nSkipped {
+				return 0
+			}
+		}
+		return nSkipped
+	}
+
+	for {
+		if err := readByte(); err != nil {
+			return skipped(0), err
+		}
 
 		if magicByteBuf[0] == magicBytes[0] {
 			var goodSeq = true
 			for i := 1; i < len(magicBytes); i++ {
-				err := readBytes(rdr.streamReader, magicByteBuf)
-				if err != nil {
-					return nRead, err
+				if err := readByte(); err != nil {
+					return skipped(0), err
 				}
-				nRead++
 
 				if magicByteBuf[0] != magicBytes[i] {
 					goodSeq = false
@@ -363,7 +576,37 @@ func (rdr *Reader) syncToMagic() (int, error) {
 		}
 	}
 
-	return nRead, nil
+	return skipped(len(magicBytes)), nil
+}
+
+// readStream fills buf from the underlying stream, keeping track of the
+// stream offset.  Bytes of an incomplete read are counted as well.  Bytes
+// that were put back with rewindStream are read first.
+func (rdr *Reader) readStream(buf []byte) error {
+	tot := copy(buf, rdr.rescan)
+	rdr.rescan = rdr.rescan[tot:]
+	for tot < len(buf) {
+		n, err := rdr.streamReader.Read(buf[tot:])
+		tot += n
+		if err != nil && tot != len(buf) {
+			rdr.streamOffset += int64(tot)
+			return err
+		}
+	}
+	rdr.streamOffset += int64(tot)
+	return nil
+}
+
+// rewindStream moves the stream back to an earlier offset, given the bytes
+// that have been read from there.  Seekable streams are sought, while the
+// bytes of other streams are kept to be read again.
+func (rdr *Reader) rewindStream(offset int64, readBytes []byte) error {
+	if _, ok := rdr.streamReader.(io.Seeker); ok {
+		return rdr.seekStream(offset)
+	}
+	rdr.rescan = append(append([]byte{}, readBytes...), rdr.rescan...)
+	rdr.streamOffset = offset
+	return nil
 }
 
 func readBytes(rdr io.Reader, buf []byte) error {
@@ -0,0 +1,400 @@
+package proio
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	protobuf "github.com/golang/protobuf/proto"
+	"github.com/jhump/protoreflect/dynamic"
+)
+
+// ReferenceKind describes the relationship between an entry and the entries
+// whose IDs are stored in one of its reference fields.
+type ReferenceKind int
+
+const (
+	// GenericReference fields refer to related entries, such as the hits of a
+	// track.
+	GenericReference ReferenceKind = iota
+	// ParentReference fields refer to the parents of an entry.
+	ParentReference
+	// ChildReference fields refer to the children of an entry.
+	ChildReference
+)
+
+func (kind ReferenceKind) String() string {
+	switch kind {
+	case ParentReference:
+		return "parent"
+	case ChildReference:
+		return "child"
+	}
+	return "generic"
+}
+
+// RegisterReferenceField declares that a field of a message type holds entry
+// IDs that refer to other entries in the same Event.  typeName is the
+// fully-qualified name of the message type (e.g. "proio.model.lcio.MCParticle"),
+// and fieldPath is the name of the field, or a dot-separated path of field
+// names for a field of a nested message (e.g. "contributions.MCParticle").  The
+// field must be a uint64 field, either singular or repeated, and IDs of 0 are
+// taken to be null references.  The reference fields of the proio data models
+// are registered already.  RegisterReferenceField may be called concurrently
+// with the building of reference graphs, but it is typically called from an
+// init function.
+func RegisterReferenceField(typeName string, fieldPath string, kind ReferenceKind) {
+	referenceFieldsMutex.Lock()
+	defer referenceFieldsMutex.Unlock()
+
+	for i, field := range referenceFields[typeName] {
+		if field.path == fieldPath {
+			referenceFields[typeName][i].kind = kind
+			return
+		}
+	}
+	referenceFields[typeName] = append(referenceFields[typeName], referenceField{fieldPath, kind})
+}
+
+type referenceField struct {
+	path string
+	kind ReferenceKind
+}
+
+var (
+	referenceFieldsMutex sync.RWMutex
+	referenceFields      = map[string][]referenceField{
+		"proio.model.eic.Particle": {
+			{"parent", ParentReference},
+			{"child", ChildReference},
+		},
+		"proio.model.eic.EnergyDep":    {{"source", GenericReference}},
+		"proio.model.eic.KernelMatrix": {{"observation", GenericReference}},
+		"proio.model.eic.Track":        {{"observation", GenericReference}},
+		"proio.model.eic.CaloShower":   {{"observation", GenericReference}},
+		"proio.model.example.Particle": {
+			{"parent", ParentReference},
+			{"child", ChildReference},
+		},
+		"proio.model.example.VarintParticle": {
+			{"parent", ParentReference},
+			{"child", ChildReference},
+		},
+		"proio.model.lcio.MCParticle": {
+			{"parents", ParentReference},
+			{"children", ChildReference},
+		},
+		"proio.model.lcio.SimTrackerHit":       {{"mc", GenericReference}},
+		"proio.model.lcio.TrackerHit":          {{"rawHits", GenericReference}},
+		"proio.model.lcio.TrackerPulse":        {{"TPC", GenericReference}},
+		"proio.model.lcio.TrackerHitPlane":     {{"rawHits", GenericReference}},
+		"proio.model.lcio.TrackerHitZCylinder": {{"rawHits", GenericReference}},
+		"proio.model.lcio.Track": {
+			{"hits", GenericReference},
+			{"tracks", GenericReference},
+		},
+		"proio.model.lcio.SimCalorimeterHit": {{"contributions.MCParticle", GenericReference}},
+		"proio.model.lcio.CalorimeterHit":    {{"raw", GenericReference}},
+		"proio.model.lcio.Cluster": {
+			{"clusters", GenericReference},
+			{"hits", GenericReference},
+		},
+		"proio.model.lcio.RecParticle": {
+			{"recs", GenericReference},
+			{"tracks", GenericReference},
+			{"clusters", GenericReference},
+			{"startVtx", GenericReference},
+		},
+		"proio.model.lcio.Vertex": {{"recPart", GenericReference}},
+		"proio.model.lcio.Relation": {
+			{"from", GenericReference},
+			{"to", GenericReference},
+		},
+		"proio.model.mc.Particle": {
+			{"parent", ParentReference},
+			{"child", ChildReference},
+		},
+		"proio.model.mc.VarintParticle": {
+			{"parent", ParentReference},
+			{"child", ChildReference},
+		},
+	}
+)
+
+func registeredReferenceFields(typeName string) []referenceField {
+	referenceFieldsMutex.RLock()
+	defer referenceFieldsMutex.RUnlock()
+	return referenceFields[typeName]
+}
+
+// Reference is a reference from one entry to another, as stored in a
+// registered reference field.
+type Reference struct {
+	From  uint64
+	To    uint64
+	Field string
+	Kind  ReferenceKind
+}
+
+// EntryReferences returns the references stored in the registered reference
+// fields of an entry, in the order of the registered fields and the order of
+// the IDs within them.
+func (evt *Event) EntryReferences(id uint64) ([]Reference, error) {
+	entryProto, ok := evt.proto.Entry[id]
+	if !ok {
+		return nil, errors.New("no such entry: " + strconv.FormatUint(id, 10))
+	}
+	fields := registeredReferenceFields(evt.proto.Type[entryProto.Type])
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	entry := evt.GetDynamicEntry(id)
+	if entry == nil {
+		return nil, evt.Err
+	}
+
+	var refs []Reference
+	for _, field := range fields {
+		err := mapReferenceField(entry, strings.Split(field.path, "."), func(to uint64) uint64 {
+			refs = append(refs, Reference{id, to, field.path, field.kind})
+			return to
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return refs, nil
+}
+
+// mapReferenceField calls mapping for each non-zero ID stored in the field at
+// path, and replaces the ID with the returned value.  IDs in repeated fields
+// that are mapped to 0 are removed.
+func mapReferenceField(msg *dynamic.Message, path []string, mapping func(uint64) uint64) error {
+	md := msg.GetMessageDescriptor()
+	fd := md.FindFieldByName(path[0])
+	if fd == nil {
+		return errors.New("no field " + path[0] + " in type " + md.GetFullyQualifiedName())
+	}
+
+	if len(path) > 1 {
+		if fd.GetMessageType() == nil || fd.IsMap() {
+			return errors.New("field " + path[0] + " of type " + md.GetFullyQualifiedName() + " is not a message")
+		}
+		var elements []interface{}
+		if fd.IsRepeated() {
+			elements = msg.GetField(fd).([]interface{})
+		} else if msg.HasField(fd) {
+			elements = []interface{}{msg.GetField(fd)}
+		}
+		for i, element := range elements {
+			subMsg, ok := element.(*dynamic.Message)
+			if !ok {
+				var err error
+				if subMsg, err = dynamic.AsDynamicMessage(element.(protobuf.Message)); err != nil {
+					return err
+				}
+			}
+			if err := mapReferenceField(subMsg, path[1:], mapping); err != nil {
+				return err
+			}
+			if fd.IsRepeated() {
+				msg.SetRepeatedField(fd, i, subMsg)
+			} else {
+				msg.SetField(fd, subMsg)
+			}
+		}
+		return nil
+	}
+
+	if fd.IsRepeated() {
+		values, ok := msg.GetField(fd).([]interface{})
+		if !ok {
+			return errors.New("field " + path[0] + " of type " + md.GetFullyQualifiedName() + " is not a reference field")
+		}
+		newValues := make([]uint64, 0, len(values))
+		for _, value := range values {
+			id, ok := value.(uint64)
+			if !ok {
+				return errors.New("field " + path[0] + " of type " + md.GetFullyQualifiedName() + " is not a reference field")
+			}
+			if id != 0 {
+				if id = mapping(id); id == 0 {
+					continue
+				}
+			}
+			newValues = append(newValues, id)
+		}
+		if len(newValues) != len(values) {
+			msg.SetField(fd, newValues)
+		} else {
+			for i, id := range newValues {
+				msg.SetRepeatedField(fd, i, id)
+			}
+		}
+		return nil
+	}
+
+	id, ok := msg.GetField(fd).(uint64)
+	if !ok {
+		return errors.New("field " + path[0] + " of type " + md.GetFullyQualifiedName() + " is not a reference field")
+	}
+	if id != 0 {
+		msg.SetField(fd, mapping(id))
+	}
+	return nil
+}
+
+// RefGraph is a graph of the references between the entries of an Event, built
+// from the registered reference fields of the entries.  Parent and child
+// references form a family tree (or rather, a directed graph), where an entry
+// that lists another as its parent, or is listed by another as its child, is
+// that entry's child.  A RefGraph is a snapshot of the Event at the time that
+// it is built.
+type RefGraph struct {
+	entries  map[uint64]bool
+	refs     map[uint64][]Reference
+	parents  map[uint64][]uint64
+	children map[uint64][]uint64
+	dangling []Reference
+}
+
+// ReferenceGraph builds the graph of references between the entries of the
+// Event.  Entries of types without registered reference fields are part of
+// the graph, but do not refer to other entries.
+func (evt *Event) ReferenceGraph() (*RefGraph, error) {
+	graph := &RefGraph{
+		entries:  make(map[uint64]bool),
+		refs:     make(map[uint64][]Reference),
+		parents:  make(map[uint64][]uint64),
+		children: make(map[uint64][]uint64),
+	}
+	for id := range evt.proto.Entry {
+		graph.entries[id] = true
+	}
+
+	for _, id := range sortEntries(evt.AllEntries()) {
+		refs, err := evt.EntryReferences(id)
+		if err != nil {
+			return nil, err
+		}
+		graph.refs[id] = refs
+
+		for _, ref := range refs {
+			if !graph.entries[ref.To] {
+				graph.dangling = append(graph.dangling, ref)
+				continue
+			}
+			switch ref.Kind {
+			case ParentReference:
+				graph.addFamilyEdge(ref.To, ref.From)
+			case ChildReference:
+				graph.addFamilyEdge(ref.From, ref.To)
+			}
+		}
+	}
+	return graph, nil
+}
+
+func (graph *RefGraph) addFamilyEdge(parent uint64, child uint64) {
+	for _, id := range graph.children[parent] {
+		if id == child {
+			return
+		}
+	}
+	graph.children[parent] = append(graph.children[parent], child)
+	graph.parents[child] = append(graph.parents[child], parent)
+}
+
+// References returns the references stored in an entry, including dangling
+// ones.
+func (graph *RefGraph) References(id uint64) []Reference {
+	return append([]Reference{}, graph.refs[id]...)
+}
+
+// Parents returns the IDs of the parents of an entry, in increasing order.
+func (graph *RefGraph) Parents(id uint64) []uint64 {
+	return sortEntries(append([]uint64(nil), graph.parents[id]...))
+}
+
+// Children returns the IDs of the children of an entry, in increasing order.
+func (graph *RefGraph) Children(id uint64) []uint64 {
+	return sortEntries(append([]uint64(nil), graph.children[id]...))
+}
+
+// Ancestors returns the IDs of the parents of an entry, their parents, and so
+// on, in increasing order.  The entry itself is only included if it is its
+// own ancestor.
+func (graph *RefGraph) Ancestors(id uint64) []uint64 {
+	return graph.walk(id, graph.parents)
+}
+
+// Descendants returns the IDs of the children of an entry, their children,
+// and so on, in increasing order.  The entry itself is only included if it is
+// its own descendant.
+func (graph *RefGraph) Descendants(id uint64) []uint64 {
+	return graph.walk(id, graph.children)
+}
+
+func (graph *RefGraph) walk(id uint64, edges map[uint64][]uint64) []uint64 {
+	visited := make(map[uint64]bool)
+	var found []uint64
+	queue := []uint64{id}
+	for len(queue) > 0 {
+		for _, next := range edges[queue[0]] {
+			if !visited[next] {
+				visited[next] = true
+				found = append(found, next)
+				queue = append(queue, next)
+			}
+		}
+		queue = queue[1:]
+	}
+	return sortEntries(found)
+}
+
+// Dangling returns the references to IDs of entries that are not in the
+// Event, ordered by the referring entry.
+func (graph *RefGraph) Dangling() []Reference {
+	return append([]Reference{}, graph.dangling...)
+}
+
+// TopologicalOrder returns the IDs of all entries in an order where parents
+// come before their children.  Among entries whose parents have all been
+// ordered, the lowest ID comes first.  An error is returned if the parent and
+// child references form a cycle.
+func (graph *RefGraph) TopologicalOrder() ([]uint64, error) {
+	nParents := make(map[uint64]int)
+	var ready []uint64
+	for id := range graph.entries {
+		nParents[id] = len(graph.parents[id])
+		if nParents[id] == 0 {
+			ready = append(ready, id)
+		}
+	}
+	sortEntries(ready)
+
+	order := make([]uint64, 0, len(graph.entries))
+	for len(ready) > 0 {
+		id := ready[0]
+		ready = ready[1:]
+		order = append(order, id)
+
+		for _, child := range graph.children[id] {
+			nParents[child]--
+			if nParents[child] == 0 {
+				i := sort.Search(len(ready), func(i int) bool { return ready[i] > child })
+				ready = append(ready, 0)
+				copy(ready[i+1:], ready[i:])
+				ready[i] = child
+			}
+		}
+	}
+
+	if len(order) != len(graph.entries) {
+		return nil, errors.New("parent and child references form a cycle")
+	}
+	return order, nil
+}
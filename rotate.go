@@ -0,0 +1,158 @@
+package proio
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	proto "github.com/proio-org/go-proio-pb"
+)
+
+// CreateRotating is like Create, except that the output is split across a
+// series of numbered files.  The filenames are made by formatting pattern
+// with the file number, starting at 0 (e.g. "run%04d.proio").  A new file is
+// started before a bucket would take the current file beyond maxBytes bytes
+// (not counting an index footer), or beyond maxEvents events.  A value of
+// zero disables either limit.  Since files are only split between buckets,
+// maxBytes should be well above the Writer's BucketDumpThres, and a single
+// bucket that is larger than maxBytes is written to a file of its own.  The
+// first bucket header of each file repeats all metadata and
+// FileDescriptorProtos that were written to previous files, so that each file
+// can be read on its own.
+func CreateRotating(pattern string, maxBytes int64, maxEvents uint64) (*Writer, error) {
+	if strings.Contains(fmt.Sprintf(pattern, 0), "%!") {
+		return nil, errors.New("invalid filename pattern: " + pattern)
+	}
+
+	file, err := os.Create(fmt.Sprintf(pattern, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	writer := NewWriter(file)
+	writer.rotation = &rotation{
+		pattern:   pattern,
+		maxBytes:  maxBytes,
+		maxEvents: maxEvents,
+		file:      file,
+		metadata:  make(map[string][]byte),
+	}
+	writer.DeferUntilClose(func() error { return writer.rotation.file.Close() })
+
+	return writer, nil
+}
+
+// Rotate makes a Writer created with CreateRotating start a new file with the
+// next bucket that it writes, regardless of the limits.  An error is returned
+// for other Writers.
+func (wrt *Writer) Rotate() error {
+	if wrt.rotation == nil {
+		return errors.New("writer does not rotate files")
+	}
+	wrt.bucketMutex.Lock()
+	defer wrt.bucketMutex.Unlock()
+	if err := wrt.flush(); err != nil {
+		return err
+	}
+	wrt.rotation.force = true
+	wrt.rotation.nPushed = 0
+	return nil
+}
+
+type rotation struct {
+	pattern   string
+	maxBytes  int64
+	maxEvents uint64
+	fileNum   int
+	file      *os.File
+
+	// events pushed to the Writer, counted from within Push
+	nPushed uint64
+	force   bool
+
+	// state of the output, maintained from within writeFrame
+	nFileEvents uint64
+	metadata    map[string][]byte
+	fdBytes     [][]byte
+}
+
+// rotatePush makes sure that buckets are flushed whenever the number of events
+// pushed reaches a multiple of maxEvents, so that files can be split after
+// exactly maxEvents events.
+func (wrt *Writer) rotatePush() error {
+	if wrt.rotation == nil || wrt.rotation.maxEvents == 0 {
+		return nil
+	}
+	wrt.rotation.nPushed++
+	if wrt.rotation.nPushed%wrt.rotation.maxEvents == 0 {
+		return wrt.flushBucket()
+	}
+	return nil
+}
+
+// rotateFrame starts a new file if the given frame would take the current file
+// beyond its limits.  The returned header is the one to write, which carries
+// the metadata and FileDescriptorProtos of previous files if a new file was
+// started.
+func (wrt *Writer) rotateFrame(header *proto.BucketHeader, frameSize int) (*proto.BucketHeader, error) {
+	rot := wrt.rotation
+	if rot == nil {
+		return header, nil
+	}
+
+	outHeader := header
+	if len(wrt.index.Buckets) > 0 &&
+		(rot.force ||
+			rot.maxBytes > 0 && wrt.streamOffset+int64(frameSize) > rot.maxBytes ||
+			rot.maxEvents > 0 && rot.nFileEvents+header.NEvents > rot.maxEvents) {
+		if err := wrt.rotate(); err != nil {
+			return nil, err
+		}
+
+		newHeader := *header
+		newHeader.Metadata = make(map[string][]byte)
+		for key, value := range rot.metadata {
+			newHeader.Metadata[key] = value
+		}
+		for key, value := range header.Metadata {
+			newHeader.Metadata[key] = value
+		}
+		newHeader.FileDescriptor = append(append([][]byte{}, rot.fdBytes...), header.FileDescriptor...)
+		outHeader = &newHeader
+	}
+
+	for key, value := range header.Metadata {
+		rot.metadata[key] = value
+	}
+	rot.fdBytes = append(rot.fdBytes, header.FileDescriptor...)
+	rot.nFileEvents += header.NEvents
+	rot.force = false
+
+	return outHeader, nil
+}
+
+// rotate finishes the current file and starts the next one.
+func (wrt *Writer) rotate() error {
+	rot := wrt.rotation
+
+	if err := wrt.writeIndexFooter(); err != nil {
+		return err
+	}
+	if err := rot.file.Close(); err != nil {
+		return err
+	}
+
+	file, err := os.Create(fmt.Sprintf(rot.pattern, rot.fileNum+1))
+	if err != nil {
+		return err
+	}
+	rot.fileNum++
+	rot.file = file
+	rot.nFileEvents = 0
+
+	wrt.streamWriter = file
+	wrt.streamOffset = 0
+	wrt.index = Index{}
+	return nil
+}
@@ -0,0 +1,664 @@
+package proio
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	protobuf "github.com/golang/protobuf/proto"
+	"github.com/jhump/protoreflect/dynamic"
+)
+
+// Selection is a parsed selection expression, which can be used to select
+// events, or entries within events.  Selection expressions combine tag names
+// and comparisons of entry fields with the boolean operators && (or &), ||
+// (or |) and !, as well as parentheses.  For example,
+//
+//	Particle.pdg == 11 && Truth
+//
+// A bare tag name, such as Truth, tests whether an entry has the tag.  A tag
+// name followed by a dot-separated path of field names, such as
+// Particle.vertex.z, refers to a field of an entry with the tag, and it can be
+// compared with the operators ==, !=, <, <=, > and >= to numbers, quoted
+// strings, true, false, other fields, or counts.  A field path that is not
+// compared tests whether the field is non-zero.  Repeated fields match if any
+// of their values match, and enum fields can be compared either to numbers or
+// to the quoted names of their values.  Field contents are decoded with the
+// FileDescriptorProtos collected from streams (see GetDynamicEntry), so the
+// entry types do not need to be linked with the current executable.  Tag names
+// that are not made up of letters, digits and underscores can be quoted with
+// backquotes.
+//
+// count(expression) evaluates to the number of entries in the event that match
+// the expression, so that for example
+//
+//	count(Particle.pdg == 11 || Particle.pdg == -11) >= 2
+//
+// selects events with at least two electrons or positrons.
+//
+// When a Selection is matched against an entry (see MatchEntry), the
+// expression is evaluated for that entry.  When it is matched against an event
+// (see MatchEvent), each tag name or comparison involving fields instead tests
+// whether the event has any entry that satisfies it, independently of the
+// other terms of the expression.
+type Selection struct {
+	source string
+	root   selNode
+}
+
+// ParseSelection parses a selection expression.
+func ParseSelection(expr string) (*Selection, error) {
+	tokens, err := lexSelection(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &selParser{tokens: tokens}
+	root, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := parser.peek(); tok.kind != selEOF {
+		return nil, fmt.Errorf("unexpected %v at position %v in selection", tok, tok.pos)
+	}
+
+	return &Selection{source: expr, root: root}, nil
+}
+
+func (sel *Selection) String() string {
+	return sel.source
+}
+
+// MatchEvent returns whether or not the Event is selected by the expression.
+func (sel *Selection) MatchEvent(event *Event) (bool, error) {
+	return sel.root.eval(newSelContext(event), 0, false)
+}
+
+// MatchEntry returns whether or not the entry with the given ID is selected by
+// the expression.
+func (sel *Selection) MatchEntry(event *Event, id uint64) (bool, error) {
+	return sel.root.eval(newSelContext(event), id, true)
+}
+
+// SelectEntries returns the IDs of all entries in the Event that are selected
+// by the expression, in increasing order.
+func (sel *Selection) SelectEntries(event *Event) ([]uint64, error) {
+	return newSelContext(event).selectEntries(sel.root)
+}
+
+// selContext caches tag lookups and decoded entries during the evaluation of
+// an expression for one event.
+type selContext struct {
+	event   *Event
+	tags    map[string]map[uint64]bool
+	entries map[uint64]*dynamic.Message
+}
+
+func newSelContext(event *Event) *selContext {
+	return &selContext{
+		event:   event,
+		tags:    make(map[string]map[uint64]bool),
+		entries: make(map[uint64]*dynamic.Message),
+	}
+}
+
+func (ctx *selContext) tagged(tag string) map[uint64]bool {
+	ids, ok := ctx.tags[tag]
+	if !ok {
+		ids = make(map[uint64]bool)
+		for _, id := range ctx.event.TaggedEntries(tag) {
+			ids[id] = true
+		}
+		ctx.tags[tag] = ids
+	}
+	return ids
+}
+
+func (ctx *selContext) entry(id uint64) (*dynamic.Message, error) {
+	entry, ok := ctx.entries[id]
+	if !ok {
+		entry = ctx.event.GetDynamicEntry(id)
+		if entry == nil {
+			return nil, ctx.event.Err
+		}
+		ctx.entries[id] = entry
+	}
+	return entry, nil
+}
+
+func (ctx *selContext) selectEntries(node selNode) ([]uint64, error) {
+	var ids []uint64
+	for _, id := range ctx.event.AllEntries() {
+		match, err := node.eval(ctx, id, true)
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			ids = append(ids, id)
+		}
+	}
+	return sortEntries(ids), nil
+}
+
+// selNode is a boolean term of a selection expression.  If hasEntry is true,
+// the term is evaluated for the entry with the given ID, and otherwise for the
+// event as a whole.
+type selNode interface {
+	eval(ctx *selContext, id uint64, hasEntry bool) (bool, error)
+}
+
+type selOr struct{ left, right selNode }
+
+func (node *selOr) eval(ctx *selContext, id uint64, hasEntry bool) (bool, error) {
+	match, err := node.left.eval(ctx, id, hasEntry)
+	if err != nil || match {
+		return match, err
+	}
+	return node.right.eval(ctx, id, hasEntry)
+}
+
+type selAnd struct{ left, right selNode }
+
+func (node *selAnd) eval(ctx *selContext, id uint64, hasEntry bool) (bool, error) {
+	match, err := node.left.eval(ctx, id, hasEntry)
+	if err != nil || !match {
+		return match, err
+	}
+	return node.right.eval(ctx, id, hasEntry)
+}
+
+type selNot struct{ operand selNode }
+
+func (node *selNot) eval(ctx *selContext, id uint64, hasEntry bool) (bool, error) {
+	match, err := node.operand.eval(ctx, id, hasEntry)
+	return !match, err
+}
+
+type selTag struct{ tag string }
+
+func (node *selTag) eval(ctx *selContext, id uint64, hasEntry bool) (bool, error) {
+	if hasEntry {
+		return ctx.tagged(node.tag)[id], nil
+	}
+	return len(ctx.tagged(node.tag)) > 0, nil
+}
+
+// selCompare compares two operands, or tests a single operand for being
+// non-zero if op is empty.
+type selCompare struct {
+	left, right selOperand
+	op          string
+}
+
+func (node *selCompare) eval(ctx *selContext, id uint64, hasEntry bool) (bool, error) {
+	if hasEntry {
+		return node.evalEntry(ctx, id, true)
+	}
+
+	// at the event level, look for an entry that satisfies the comparison
+	var path *selPath
+	for _, operand := range []selOperand{node.left, node.right} {
+		if operandPath, ok := operand.(*selPath); ok {
+			path = operandPath
+			break
+		}
+	}
+	if path == nil {
+		return node.evalEntry(ctx, 0, false)
+	}
+
+	ids := make([]uint64, 0, len(ctx.tagged(path.tag)))
+	for id := range ctx.tagged(path.tag) {
+		ids = append(ids, id)
+	}
+	for _, id := range sortEntries(ids) {
+		match, err := node.evalEntry(ctx, id, true)
+		if err != nil || match {
+			return match, err
+		}
+	}
+	return false, nil
+}
+
+func (node *selCompare) evalEntry(ctx *selContext, id uint64, hasEntry bool) (bool, error) {
+	leftValues, err := node.left.values(ctx, id, hasEntry)
+	if err != nil {
+		return false, err
+	}
+
+	if node.right == nil {
+		for _, value := range leftValues {
+			if value.isNum && value.num != 0 || value.isStr && value.str != "" {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	rightValues, err := node.right.values(ctx, id, hasEntry)
+	if err != nil {
+		return false, err
+	}
+	for _, left := range leftValues {
+		for _, right := range rightValues {
+			if left.compare(right, node.op) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// selValue is a value that appears in a comparison.  Enum values are both
+// numbers and strings.
+type selValue struct {
+	isNum bool
+	num   float64
+	isStr bool
+	str   string
+}
+
+func (left selValue) compare(right selValue, op string) bool {
+	var cmp int
+	switch {
+	case left.isNum && right.isNum:
+		switch {
+		case left.num < right.num:
+			cmp = -1
+		case left.num > right.num:
+			cmp = 1
+		}
+	case left.isStr && right.isStr:
+		cmp = strings.Compare(left.str, right.str)
+	default:
+		return false
+	}
+
+	switch op {
+	case "==":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	}
+	return false
+}
+
+type selOperand interface {
+	values(ctx *selContext, id uint64, hasEntry bool) ([]selValue, error)
+}
+
+type selLiteral struct{ value selValue }
+
+func (operand *selLiteral) values(ctx *selContext, id uint64, hasEntry bool) ([]selValue, error) {
+	return []selValue{operand.value}, nil
+}
+
+type selCount struct{ expr selNode }
+
+func (operand *selCount) values(ctx *selContext, id uint64, hasEntry bool) ([]selValue, error) {
+	ids, err := ctx.selectEntries(operand.expr)
+	if err != nil {
+		return nil, err
+	}
+	return []selValue{{isNum: true, num: float64(len(ids))}}, nil
+}
+
+// selPath refers to a field of entries with the given tag.
+type selPath struct {
+	tag    string
+	fields []string
+}
+
+func (operand *selPath) values(ctx *selContext, id uint64, hasEntry bool) ([]selValue, error) {
+	if !hasEntry || !ctx.tagged(operand.tag)[id] {
+		return nil, nil
+	}
+	entry, err := ctx.entry(id)
+	if err != nil {
+		return nil, err
+	}
+	return fieldValues(entry, operand.fields)
+}
+
+func fieldValues(msg *dynamic.Message, fields []string) ([]selValue, error) {
+	md := msg.GetMessageDescriptor()
+	fd := md.FindFieldByName(fields[0])
+	if fd == nil {
+		return nil, errors.New("no field " + fields[0] + " in type " + md.GetFullyQualifiedName())
+	}
+	if fd.IsMap() {
+		return nil, errors.New("map field " + fields[0] + " cannot be selected on")
+	}
+
+	var elements []interface{}
+	if fd.IsRepeated() {
+		elements = msg.GetField(fd).([]interface{})
+	} else {
+		elements = []interface{}{msg.GetField(fd)}
+	}
+
+	var values []selValue
+	for _, element := range elements {
+		if fd.GetMessageType() != nil {
+			if len(fields) == 1 {
+				return nil, errors.New("message field " + fields[0] + " cannot be compared")
+			}
+			subMsg, ok := element.(protobuf.Message)
+			if !ok || subMsg == nil {
+				continue
+			}
+			dynSubMsg, err := dynamic.AsDynamicMessage(subMsg)
+			if err != nil {
+				return nil, err
+			}
+			subValues, err := fieldValues(dynSubMsg, fields[1:])
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, subValues...)
+			continue
+		}
+
+		if len(fields) > 1 {
+			return nil, errors.New("field " + fields[0] + " has no fields")
+		}
+		var value selValue
+		switch element := element.(type) {
+		case int32:
+			value = selValue{isNum: true, num: float64(element)}
+			if enum := fd.GetEnumType(); enum != nil {
+				if enumValue := enum.FindValueByNumber(element); enumValue != nil {
+					value.isStr = true
+					value.str = enumValue.GetName()
+				}
+			}
+		case int64:
+			value = selValue{isNum: true, num: float64(element)}
+		case uint32:
+			value = selValue{isNum: true, num: float64(element)}
+		case uint64:
+			value = selValue{isNum: true, num: float64(element)}
+		case float32:
+			value = selValue{isNum: true, num: float64(element)}
+		case float64:
+			value = selValue{isNum: true, num: element}
+		case bool:
+			value = selValue{isNum: true}
+			if element {
+				value.num = 1
+			}
+		case string:
+			value = selValue{isStr: true, str: element}
+		case []byte:
+			value = selValue{isStr: true, str: string(element)}
+		default:
+			continue
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+const (
+	selEOF = iota
+	selIdent
+	selQuotedIdent
+	selNumber
+	selString
+	selOp
+)
+
+type selToken struct {
+	kind int
+	text string
+	pos  int
+}
+
+func (tok selToken) String() string {
+	if tok.kind == selEOF {
+		return "end of expression"
+	}
+	return strconv.Quote(tok.text)
+}
+
+func lexSelection(expr string) ([]selToken, error) {
+	var tokens []selToken
+	runes := []rune(expr)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		start := i
+		switch {
+		case unicode.IsSpace(r):
+			i++
+			continue
+		case r == '_' || unicode.IsLetter(r):
+			for i < len(runes) && (runes[i] == '_' || unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i])) {
+				i++
+			}
+			tokens = append(tokens, selToken{selIdent, string(runes[start:i]), start})
+		case unicode.IsDigit(r):
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.' || runes[i] == 'e' || runes[i] == 'E' ||
+				(runes[i] == '-' || runes[i] == '+') && (runes[i-1] == 'e' || runes[i-1] == 'E')) {
+				i++
+			}
+			tokens = append(tokens, selToken{selNumber, string(runes[start:i]), start})
+		case r == '"':
+			i++
+			for i < len(runes) && runes[i] != '"' {
+				if runes[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("unterminated string at position %v in selection", start)
+			}
+			i++
+			str, err := strconv.Unquote(string(runes[start:i]))
+			if err != nil {
+				return nil, fmt.Errorf("bad string at position %v in selection", start)
+			}
+			tokens = append(tokens, selToken{selString, str, start})
+		case r == '`':
+			i++
+			for i < len(runes) && runes[i] != '`' {
+				i++
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("unterminated tag name at position %v in selection", start)
+			}
+			i++
+			tokens = append(tokens, selToken{selQuotedIdent, string(runes[start+1 : i-1]), start})
+		default:
+			op := ""
+			for _, candidate := range []string{"&&", "||", "==", "!=", "<=", ">=", "&", "|", "!", "<", ">", "(", ")", ".", "-"} {
+				if strings.HasPrefix(string(runes[i:]), candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unexpected character %q at position %v in selection", r, start)
+			}
+			i += len(op)
+			tokens = append(tokens, selToken{selOp, op, start})
+		}
+	}
+	return append(tokens, selToken{kind: selEOF, pos: len(runes)}), nil
+}
+
+type selParser struct {
+	tokens []selToken
+	pos    int
+}
+
+func (parser *selParser) peek() selToken {
+	return parser.tokens[parser.pos]
+}
+
+func (parser *selParser) next() selToken {
+	tok := parser.tokens[parser.pos]
+	if tok.kind != selEOF {
+		parser.pos++
+	}
+	return tok
+}
+
+func (parser *selParser) acceptOp(ops ...string) (string, bool) {
+	tok := parser.peek()
+	if tok.kind != selOp {
+		return "", false
+	}
+	for _, op := range ops {
+		if tok.text == op {
+			parser.pos++
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func (parser *selParser) expectOp(op string) error {
+	if _, ok := parser.acceptOp(op); !ok {
+		tok := parser.peek()
+		return fmt.Errorf("expected %q instead of %v at position %v in selection", op, tok, tok.pos)
+	}
+	return nil
+}
+
+func (parser *selParser) parseOr() (selNode, error) {
+	left, err := parser.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := parser.acceptOp("||", "|"); !ok {
+			return left, nil
+		}
+		right, err := parser.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &selOr{left, right}
+	}
+}
+
+func (parser *selParser) parseAnd() (selNode, error) {
+	left, err := parser.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := parser.acceptOp("&&", "&"); !ok {
+			return left, nil
+		}
+		right, err := parser.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &selAnd{left, right}
+	}
+}
+
+func (parser *selParser) parseUnary() (selNode, error) {
+	if _, ok := parser.acceptOp("!"); ok {
+		operand, err := parser.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &selNot{operand}, nil
+	}
+
+	if _, ok := parser.acceptOp("("); ok {
+		node, err := parser.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return node, parser.expectOp(")")
+	}
+
+	left, err := parser.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	op, ok := parser.acceptOp("==", "!=", "<=", ">=", "<", ">")
+	if !ok {
+		if path, ok := left.(*selPath); ok && len(path.fields) == 0 {
+			return &selTag{path.tag}, nil
+		}
+		return &selCompare{left: left}, nil
+	}
+	right, err := parser.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	for _, operand := range []selOperand{left, right} {
+		if path, ok := operand.(*selPath); ok && len(path.fields) == 0 {
+			return nil, errors.New("tag " + path.tag + " cannot be compared")
+		}
+	}
+	return &selCompare{left, right, op}, nil
+}
+
+func (parser *selParser) parseOperand() (selOperand, error) {
+	tok := parser.next()
+	switch tok.kind {
+	case selNumber:
+		num, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bad number %v at position %v in selection", tok, tok.pos)
+		}
+		return &selLiteral{selValue{isNum: true, num: num}}, nil
+	case selString:
+		return &selLiteral{selValue{isStr: true, str: tok.text}}, nil
+	case selOp:
+		if tok.text == "-" && parser.peek().kind == selNumber {
+			operand, err := parser.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+			operand.(*selLiteral).value.num *= -1
+			return operand, nil
+		}
+	case selIdent:
+		switch tok.text {
+		case "true":
+			return &selLiteral{selValue{isNum: true, num: 1}}, nil
+		case "false":
+			return &selLiteral{selValue{isNum: true, num: 0}}, nil
+		case "count":
+			if err := parser.expectOp("("); err != nil {
+				return nil, err
+			}
+			expr, err := parser.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			return &selCount{expr}, parser.expectOp(")")
+		}
+		fallthrough
+	case selQuotedIdent:
+		path := &selPath{tag: tok.text}
+		for {
+			if _, ok := parser.acceptOp("."); !ok {
+				return path, nil
+			}
+			field := parser.next()
+			if field.kind != selIdent {
+				return nil, fmt.Errorf("expected field name instead of %v at position %v in selection", field, field.pos)
+			}
+			path.fields = append(path.fields, field.text)
+		}
+	}
+	return nil, fmt.Errorf("unexpected %v at position %v in selection", tok, tok.pos)
+}
@@ -16,8 +16,9 @@ import (
 
 var (
 	outFile        = flag.String("o", "", "create file to save output to")
-	compLevel      = flag.Int("c", 2, "compression level: 0 for uncompressed, 1 for LZ4 compression, 2 for GZIP compression, 3 for LZMA compression")
+	compLevel      = flag.Int("c", 2, "compression level: 0 for uncompressed, 1 for LZ4 compression, 2 for GZIP compression, 3 for LZMA compression, 4 for ZSTD compression")
 	updateInterval = flag.Int("u", 5, "update interval in seconds (set to 0 to disable)")
+	nWorkers       = flag.Int("j", 0, "number of goroutines compressing output buckets (0 to compress synchronously); LZMA buckets are compressed one at a time regardless")
 )
 
 func printUsage() {
@@ -60,6 +61,8 @@ func main() {
 		}
 	}
 	switch *compLevel {
+	case 4:
+		proioWriter.SetCompression(proio.ZSTD)
 	case 3:
 		proioWriter.SetCompression(proio.LZMA)
 	case 2:
@@ -69,6 +72,7 @@ func main() {
 	default:
 		proioWriter.SetCompression(proio.UNCOMPRESSED)
 	}
+	proioWriter.SetConcurrency(*nWorkers)
 	defer proioWriter.Close()
 
 	nEvents := 0
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/proio-org/go-proio"
+)
+
+var (
+	ignoreIDs      = flag.Bool("I", false, "match entries by type and tags rather than by ID, so that renumbered entries compare equal")
+	floatTolerance = flag.Float64("t", 0, "relative tolerance for differences in floating-point values")
+	ignoreMetadata = flag.Bool("m", false, "do not compare metadata")
+	maxDiffs       = flag.Int("n", 0, "maximum number of differences to print (0 for no limit)")
+	quiet          = flag.Bool("q", false, "print nothing, only set the exit status")
+)
+
+func printUsage() {
+	fmt.Fprintf(os.Stderr,
+		`Usage: proio-diff [options] <proio-input-file-or-glob> <proio-input-file-or-glob>
+
+proio-diff will compare two proio inputs event by event, and print the
+differences found in event metadata, tags, entry types and decoded entry
+contents.  Since entries are compared by content rather than by their encoded
+bytes, the inputs may differ in compression, bucket sizes, and the ordering of
+map fields.  proio-diff exits with status 0 if the inputs are equal, 1 if they
+differ, and 2 if they cannot be compared.
+
+options:
+`,
+	)
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = printUsage
+	flag.Parse()
+
+	if flag.NArg() != 2 {
+		printUsage()
+		fail("Invalid arguments")
+	}
+
+	readers := make([]*proio.Reader, 2)
+	for i := range readers {
+		filename := flag.Arg(i)
+		if filename == "-" {
+			readers[i] = proio.NewReader(bufio.NewReader(os.Stdin))
+			continue
+		}
+		var err error
+		if readers[i], err = proio.OpenGlob(filename); err != nil {
+			fail(err)
+		}
+		defer readers[i].Close()
+	}
+
+	opts := &proio.DiffOptions{
+		IgnoreIDs:      *ignoreIDs,
+		FloatTolerance: *floatTolerance,
+		IgnoreMetadata: *ignoreMetadata,
+	}
+
+	nDiffs := 0
+	report := func(nEvent int, diff string) {
+		nDiffs++
+		if !*quiet && (*maxDiffs == 0 || nDiffs <= *maxDiffs) {
+			fmt.Printf("event %v: %v\n", nEvent, diff)
+		}
+	}
+
+	for nEvent := 0; ; nEvent++ {
+		a := nextEvent(readers[0], flag.Arg(0))
+		b := nextEvent(readers[1], flag.Arg(1))
+		if a == nil && b == nil {
+			break
+		}
+		if a == nil {
+			report(nEvent, "only in "+flag.Arg(1))
+			continue
+		}
+		if b == nil {
+			report(nEvent, "only in "+flag.Arg(0))
+			continue
+		}
+
+		diffs, err := proio.DiffEvents(a, b, opts)
+		if err != nil {
+			fail("event ", nEvent, ": ", err)
+		}
+		for _, diff := range diffs {
+			report(nEvent, diff.String())
+		}
+	}
+
+	if nDiffs > 0 {
+		if !*quiet && *maxDiffs > 0 && nDiffs > *maxDiffs {
+			fmt.Printf("%v more differences\n", nDiffs-*maxDiffs)
+		}
+		os.Exit(1)
+	}
+}
+
+// nextEvent returns the next event of an input, or nil at the end of the
+// input.  Corrupt input cannot be compared, so it is fatal.
+func nextEvent(reader *proio.Reader, inputName string) *proio.Event {
+	event := reader.Next()
+	if event == nil && reader.Err != io.EOF && reader.Err != nil {
+		fail(inputName, ": ", reader.Err)
+	}
+	return event
+}
+
+func fail(v ...interface{}) {
+	log.Print(v...)
+	os.Exit(2)
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/proio-org/go-proio"
+)
+
+var (
+	outFile     = flag.String("o", "", "file to save output to")
+	invert      = flag.Bool("v", false, "keep only events that are not selected by the expression")
+	compLevel   = flag.Int("c", 2, "output compression level: 0 for uncompressed, 1 for LZ4 compression, 2 for GZIP compression, 3 for LZMA compression, 4 for ZSTD compression")
+	readBufSize = flag.Int("b", 10, "read buffer size in number of events")
+	maxEvents   = flag.Int("n", 0, "maximum number of events to read in")
+)
+
+func printUsage() {
+	fmt.Fprintf(os.Stderr,
+		`Usage: proio-filter [options] <proio-input-file-or-glob> <expression>
+
+proio-filter will take an input proio file and keep only the events that are
+selected by an expression.  The expression combines tag names and comparisons
+of entry fields with the boolean operators &&, || and !, and count(...) gives
+the number of entries in an event that match an expression.  A tag name or
+field comparison selects an event if any of its entries satisfy it.  For
+example, events with at least two electrons that also have Truth entries can be
+selected with
+
+	proio-filter input.proio 'count(Particle.pdg == 11) >= 2 && Truth'
+
+Field contents are decoded with the descriptors carried by the stream, so no
+data model needs to be compiled in.  By default, the output stream is pushed to
+stdout, but the -o option can be used to create a file at a specified path.
+The input may be a quoted glob pattern, in which case all matching files are
+read in lexical order as one continuous stream.
+
+options:
+`,
+	)
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = printUsage
+	flag.Parse()
+
+	if flag.NArg() != 2 {
+		printUsage()
+		log.Fatal("Invalid arguments")
+	}
+
+	sel, err := proio.ParseSelection(flag.Arg(1))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var reader *proio.Reader
+
+	filename := flag.Arg(0)
+	if filename == "-" {
+		stdin := bufio.NewReader(os.Stdin)
+		reader = proio.NewReader(stdin)
+	} else {
+		reader, err = proio.OpenGlob(filename)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer reader.Close()
+
+	var writer *proio.Writer
+	if *outFile == "" {
+		writer = proio.NewWriter(os.Stdout)
+	} else {
+		writer, err = proio.Create(*outFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	switch *compLevel {
+	case 4:
+		writer.SetCompression(proio.ZSTD)
+	case 3:
+		writer.SetCompression(proio.LZMA)
+	case 2:
+		writer.SetCompression(proio.GZIP)
+	case 1:
+		writer.SetCompression(proio.LZ4)
+	default:
+		writer.SetCompression(proio.UNCOMPRESSED)
+	}
+	defer writer.Close()
+
+	nEventsRead := 0
+
+	for {
+		var event *proio.Event
+		for event = range reader.ScanEvents(*readBufSize) {
+			match, err := sel.MatchEvent(event)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if match != *invert {
+				if err := writer.Push(event); err != nil {
+					log.Fatal(err)
+				}
+			}
+
+			nEventsRead++
+			if *maxEvents > 0 && nEventsRead == *maxEvents {
+				break
+			}
+		}
+
+		if reader.Err == io.EOF || reader.Err == nil {
+			break
+		} else {
+			log.Print(reader.Err)
+		}
+	}
+}
@@ -2,36 +2,46 @@ package main
 
 import (
 	"bufio"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
 	"log"
 	"os"
+	"path/filepath"
 	"reflect"
+	"time"
 
 	"github.com/proio-org/go-proio"
-	_ "github.com/proio-org/go-proio-pb/model/eic"
-	_ "github.com/proio-org/go-proio-pb/model/example"
-	_ "github.com/proio-org/go-proio-pb/model/lcio"
-	_ "github.com/proio-org/go-proio-pb/model/mc"
 )
 
 var (
 	ignore        = flag.Bool("i", false, "ignore the specified tags instead of isolating them")
 	event         = flag.Int64("e", -1, "list specified event, numbered consecutively from the start of the stream starting with 0")
 	printMetadata = flag.Bool("m", false, "print metadata as string")
+	printJSON     = flag.Bool("j", false, "print each event as a single line of JSON")
+	follow        = flag.Bool("f", false, "follow a file that is still being written, waiting for more events at its end")
 )
 
+// followPoll is the interval at which a followed file is checked for growth.
+const followPoll = 200 * time.Millisecond
+
 func printUsage() {
 	fmt.Fprintf(os.Stderr,
-		`Usage: proio-ls [options] <proio-input-file> [tags...]
+		`Usage: proio-ls [options] <proio-input-file-or-glob> [tags...]
 
 proio-ls will list the contents of a proio stream.  For each event, the tags
 are listed in alphabetical order followed by all entries with that tag (this
 means that entries with multiple tags will be printed multiple times).
 Optionally, tags can be specified, in which case only those tags will be shown.
 The -i flag can be specified to ignore the specified tags, instead of isolating
-them.  The -e flag can be used to isolate a specific event by its index.
+them.  The -e flag can be used to isolate a specific event by its index.  The
+-j flag prints each event (including its metadata) as a line of JSON instead,
+leaving out entries that are not referenced by any of the listed tags.  The
+input may be a quoted glob pattern, in which case all matching files are listed
+in lexical order as one continuous stream.  With the -f flag, proio-ls keeps
+listing events as they are added to a single file that is still being written,
+like "tail -f", until it is interrupted.
 
 options:
 `,
@@ -52,32 +62,53 @@ func main() {
 	var err error
 
 	filename := flag.Arg(0)
+	chained := false
 	if filename == "-" {
 		stdin := bufio.NewReader(os.Stdin)
 		reader = proio.NewReader(stdin)
+	} else if *follow {
+		reader, err = proio.OpenFollow(filename, followPoll)
 	} else {
-		reader, err = proio.Open(filename)
+		filenames, _ := filepath.Glob(filename)
+		switch len(filenames) {
+		case 0:
+			reader, err = proio.Open(filename)
+		case 1:
+			reader, err = proio.Open(filenames[0])
+		default:
+			chained = true
+			reader, err = proio.OpenFiles(filenames...)
+		}
 	}
 	if err != nil {
 		log.Fatal(err)
 	}
 	defer reader.Close()
 
+	reader.SetDamagePolicy(proio.SkipDamage)
+	reader.SetDamageHandler(func(damage *proio.DamageReport) {
+		log.Print(damage)
+	})
+
 	singleEvent := false
 	startingEvent := uint64(0)
 	if *event >= 0 {
 		singleEvent = true
 		startingEvent = uint64(*event)
-		totalSkipped := uint64(0)
-		for {
-			var nSkipped uint64
-			if nSkipped, err = reader.Skip(startingEvent - totalSkipped); err == io.EOF {
-				log.Fatal(err)
-			}
-			totalSkipped += nSkipped
-			if totalSkipped == startingEvent {
-				break
+		if filename == "-" || chained || *follow {
+			totalSkipped := uint64(0)
+			for {
+				var nSkipped uint64
+				if nSkipped, err = reader.Skip(startingEvent - totalSkipped); err == io.EOF {
+					log.Fatal(err)
+				}
+				totalSkipped += nSkipped
+				if totalSkipped == startingEvent {
+					break
+				}
 			}
+		} else if err = reader.SeekToEvent(startingEvent); err != nil {
+			log.Fatal(err)
 		}
 	}
 
@@ -105,22 +136,35 @@ rescanLoop:
 				}
 			}
 
-			if !reflect.DeepEqual(event.Metadata, lastMetadata) {
-				fmt.Println("========== META DATA ==========")
-				for key, bytes := range event.Metadata {
-					fmt.Printf("%v: ", key)
-					if *printMetadata {
-						fmt.Println(string(bytes))
-					} else {
-						fmt.Printf("%v bytes\n", len(bytes))
+			if *printJSON {
+				for _, id := range event.AllEntries() {
+					if len(event.EntryTags(id)) == 0 {
+						event.RemoveEntry(id)
 					}
 				}
-				fmt.Println()
-				lastMetadata = event.Metadata
-			}
+				eventJSON, err := json.Marshal(event)
+				if err != nil {
+					log.Fatal(err)
+				}
+				fmt.Println(string(eventJSON))
+			} else {
+				if !reflect.DeepEqual(event.Metadata, lastMetadata) {
+					fmt.Println("========== META DATA ==========")
+					for key, bytes := range event.Metadata {
+						fmt.Printf("%v: ", key)
+						if *printMetadata {
+							fmt.Println(string(bytes))
+						} else {
+							fmt.Printf("%v bytes\n", len(bytes))
+						}
+					}
+					fmt.Println()
+					lastMetadata = event.Metadata
+				}
 
-			fmt.Println("========== EVENT", nEventsRead+startingEvent, "==========")
-			fmt.Print(event)
+				fmt.Println("========== EVENT", nEventsRead+startingEvent, "==========")
+				fmt.Print(event)
+			}
 
 			nEventsRead++
 			if singleEvent {
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/proio-org/go-proio"
+)
+
+var (
+	outFile        = flag.String("o", "", "file to save output to")
+	compLevel      = flag.Int("c", 2, "output compression level: 0 for uncompressed, 1 for LZ4 compression, 2 for GZIP compression, 3 for LZMA compression, 4 for ZSTD compression")
+	bucketSize     = flag.Int("b", 0, "output bucket size threshold in bytes (0 for the default)")
+	metadataPolicy = flag.String("m", "last", "policy for metadata keys with conflicting values in different inputs: \"last\" to take each input's values, \"first\" to keep the value from the first input that set the key, or \"error\" to fail")
+	interleave     = flag.Bool("I", false, "interleave events from the inputs in turn, rather than concatenating the inputs")
+	nWorkers       = flag.Int("j", 0, "number of goroutines compressing output buckets (0 to compress synchronously); LZMA buckets are compressed one at a time regardless")
+)
+
+func printUsage() {
+	fmt.Fprintf(os.Stderr,
+		`Usage: proio-merge [options] <proio-input-files-or-globs...>
+
+proio-merge will merge multiple proio inputs into a single output stream.  By
+default, the inputs are concatenated in the order given (with globs expanded in
+lexical order), but the -I flag can be used to take one event from each input
+in turn instead.  The output is re-encoded, so that FileDescriptorProtos are
+only written once and the compression and bucket size can be chosen freely.
+Note that a metadata key that is set by one input remains set for the events
+of the following inputs, unless they set it themselves.  By default, the output
+stream is pushed to stdout, but the -o option can be used to create a file at a
+specified path.
+
+options:
+`,
+	)
+	flag.PrintDefaults()
+}
+
+type metadataOwner struct {
+	input string
+	value []byte
+}
+
+func main() {
+	flag.Usage = printUsage
+	flag.Parse()
+
+	if flag.NArg() < 1 {
+		printUsage()
+		log.Fatal("Invalid arguments")
+	}
+	switch *metadataPolicy {
+	case "last", "first", "error":
+	default:
+		printUsage()
+		log.Fatal("Invalid metadata policy: ", *metadataPolicy)
+	}
+
+	var inputNames []string
+	for _, arg := range flag.Args() {
+		if arg == "-" {
+			inputNames = append(inputNames, arg)
+			continue
+		}
+		filenames, err := filepath.Glob(arg)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(filenames) == 0 {
+			filenames = []string{arg}
+		}
+		inputNames = append(inputNames, filenames...)
+	}
+
+	// all inputs are opened up front only for interleaving, since any number
+	// of files can be concatenated by opening them one at a time
+	var readers []*proio.Reader
+	if *interleave {
+		readers = make([]*proio.Reader, len(inputNames))
+		for i, name := range inputNames {
+			readers[i] = openInputs(name)
+			defer readers[i].Close()
+		}
+	} else {
+		for _, name := range inputNames {
+			if name == "-" {
+				continue
+			}
+			if _, err := os.Stat(name); err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+
+	var writer *proio.Writer
+	var err error
+	if *outFile == "" {
+		writer = proio.NewWriter(os.Stdout)
+	} else {
+		writer, err = proio.Create(*outFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	switch *compLevel {
+	case 4:
+		writer.SetCompression(proio.ZSTD)
+	case 3:
+		writer.SetCompression(proio.LZMA)
+	case 2:
+		writer.SetCompression(proio.GZIP)
+	case 1:
+		writer.SetCompression(proio.LZ4)
+	default:
+		writer.SetCompression(proio.UNCOMPRESSED)
+	}
+	if *bucketSize > 0 {
+		writer.BucketDumpThres = *bucketSize
+	}
+	writer.SetConcurrency(*nWorkers)
+
+	owners := make(map[string]metadataOwner)
+	push := func(event *proio.Event, input string) {
+		for key, value := range event.Metadata {
+			owner, ok := owners[key]
+			if !ok || owner.input == input {
+				owners[key] = metadataOwner{input, value}
+				continue
+			}
+			if bytes.Equal(owner.value, value) {
+				continue
+			}
+
+			switch *metadataPolicy {
+			case "first":
+				event.Metadata[key] = owner.value
+			case "error":
+				log.Fatalf(
+					"metadata key %v in %v conflicts with %v",
+					key, input, owner.input,
+				)
+			default:
+				owners[key] = metadataOwner{input, value}
+			}
+		}
+
+		if err := writer.Push(event); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	if *interleave {
+		done := make([]bool, len(readers))
+		nDone := 0
+		for nDone < len(readers) {
+			for i, reader := range readers {
+				if done[i] {
+					continue
+				}
+				if event := nextEvent(reader); event != nil {
+					push(event, inputName(reader))
+				} else {
+					done[i] = true
+					nDone++
+				}
+			}
+		}
+	} else {
+		// consecutive files are chained, so that each is only opened once it
+		// is reached
+		for len(inputNames) > 0 {
+			n := 1
+			for inputNames[0] != "-" && n < len(inputNames) && inputNames[n] != "-" {
+				n++
+			}
+			reader := openInputs(inputNames[:n]...)
+			for event := nextEvent(reader); event != nil; event = nextEvent(reader) {
+				push(event, inputName(reader))
+			}
+			reader.Close()
+			inputNames = inputNames[n:]
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// openInputs opens the given files as a chain, or returns a Reader of stdin
+// for "-".
+func openInputs(inputNames ...string) *proio.Reader {
+	if inputNames[0] == "-" {
+		return proio.NewReader(bufio.NewReader(os.Stdin))
+	}
+	reader, err := proio.OpenFiles(inputNames...)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return reader
+}
+
+// inputName returns the name of the input that a Reader is currently reading.
+func inputName(reader *proio.Reader) string {
+	if name := reader.Source(); name != "" {
+		return name
+	}
+	return "-"
+}
+
+// nextEvent returns the next event of an input, logging and skipping over
+// corrupt data, or nil at the end of the input.
+func nextEvent(reader *proio.Reader) *proio.Event {
+	for {
+		event := reader.Next()
+		if event != nil {
+			return event
+		}
+		if reader.Err == io.EOF || reader.Err == nil {
+			return nil
+		}
+		log.Print(inputName(reader), ": ", reader.Err)
+	}
+}
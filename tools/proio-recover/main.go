@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/proio-org/go-proio"
+)
+
+var (
+	outFile     = flag.String("o", "", "file to save output to")
+	compLevel   = flag.Int("c", 2, "output compression level: 0 for uncompressed, 1 for LZ4 compression, 2 for GZIP compression, 3 for LZMA compression, 4 for ZSTD compression")
+	checksums   = flag.Bool("s", false, "record bucket checksums in the output")
+	readBufSize = flag.Int("b", 10, "read buffer size in number of events")
+	quiet       = flag.Bool("q", false, "do not list damaged regions, only the totals")
+)
+
+func printUsage() {
+	fmt.Fprintf(os.Stderr,
+		`Usage: proio-recover [options] <proio-input-file-or-glob>
+
+proio-recover salvages the intact events of a damaged proio stream, such as a
+partially transferred file, and writes them to a new stream.  Damaged regions
+of the input are skipped, and each of them is listed on stderr with its byte
+offset, the number of bytes skipped, and the number of events lost where this
+can be estimated.  Totals are printed at the end.  By default, the output
+stream is pushed to stdout, but the -o option can be used to create a file at a
+specified path.  The input may be a quoted glob pattern, in which case all
+matching files are read in lexical order as one continuous stream.
+
+options:
+`,
+	)
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = printUsage
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		printUsage()
+		log.Fatal("Invalid arguments")
+	}
+
+	var reader *proio.Reader
+	var err error
+
+	filename := flag.Arg(0)
+	if filename == "-" {
+		stdin := bufio.NewReader(os.Stdin)
+		reader = proio.NewReader(stdin)
+	} else {
+		reader, err = proio.OpenGlob(filename)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer reader.Close()
+
+	reader.SetDamagePolicy(proio.SkipDamage)
+	if !*quiet {
+		reader.SetDamageHandler(func(damage *proio.DamageReport) {
+			log.Print(damage)
+		})
+	}
+
+	var writer *proio.Writer
+	if *outFile == "" {
+		writer = proio.NewWriter(os.Stdout)
+	} else {
+		writer, err = proio.Create(*outFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	switch *compLevel {
+	case 4:
+		writer.SetCompression(proio.ZSTD)
+	case 3:
+		writer.SetCompression(proio.LZMA)
+	case 2:
+		writer.SetCompression(proio.GZIP)
+	case 1:
+		writer.SetCompression(proio.LZ4)
+	default:
+		writer.SetCompression(proio.UNCOMPRESSED)
+	}
+	writer.WriteChecksums = *checksums
+
+	nEventsRecovered := 0
+	for event := range reader.ScanEvents(*readBufSize) {
+		if err := writer.Push(event); err != nil {
+			log.Fatal(err)
+		}
+		nEventsRecovered++
+	}
+	if reader.Err != io.EOF && reader.Err != nil {
+		log.Print(reader.Err)
+	}
+
+	if err := writer.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	damage := reader.Damage()
+	log.Printf(
+		"%v events recovered, %v damaged regions, %v bytes skipped, at least %v events lost",
+		nEventsRecovered,
+		damage.Regions,
+		damage.BytesSkipped,
+		damage.EventsLost,
+	)
+}
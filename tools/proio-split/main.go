@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/proio-org/go-proio"
+)
+
+var (
+	outPattern  = flag.String("o", "split%04d.proio", "output filename pattern, formatted with the output file number")
+	maxEvents   = flag.Uint64("n", 0, "maximum number of events per output file")
+	maxBytes    = flag.Int64("s", 0, "maximum size in bytes of each output file")
+	metadataKey = flag.String("k", "", "start a new output file whenever the value of this metadata key changes")
+	compLevel   = flag.Int("c", 2, "output compression level: 0 for uncompressed, 1 for LZ4 compression, 2 for GZIP compression, 3 for LZMA compression, 4 for ZSTD compression")
+	bucketSize  = flag.Int("b", 0, "output bucket size threshold in bytes (0 for the default)")
+	nWorkers    = flag.Int("j", 0, "number of goroutines compressing output buckets (0 to compress synchronously); LZMA buckets are compressed one at a time regardless")
+)
+
+func printUsage() {
+	fmt.Fprintf(os.Stderr,
+		`Usage: proio-split [options] <proio-input-file-or-glob>
+
+proio-split will divide a proio stream into numbered output files, which are
+named by formatting the -o pattern with the file number (starting at 0).  A new
+file is started once the current one reaches the number of events given by -n
+or the size given by -s, or whenever the metadata value given by -k changes.
+At least one of these options must be given, and they can be combined.  Each
+output file carries the metadata and FileDescriptorProtos that are needed to
+read it on its own.
+
+options:
+`,
+	)
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = printUsage
+	flag.Parse()
+
+	if flag.NArg() != 1 || (*maxEvents == 0 && *maxBytes == 0 && *metadataKey == "") {
+		printUsage()
+		log.Fatal("Invalid arguments")
+	}
+
+	var reader *proio.Reader
+	var err error
+
+	filename := flag.Arg(0)
+	if filename == "-" {
+		stdin := bufio.NewReader(os.Stdin)
+		reader = proio.NewReader(stdin)
+	} else {
+		reader, err = proio.OpenGlob(filename)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer reader.Close()
+
+	writer, err := proio.CreateRotating(*outPattern, *maxBytes, *maxEvents)
+	if err != nil {
+		log.Fatal(err)
+	}
+	switch *compLevel {
+	case 4:
+		writer.SetCompression(proio.ZSTD)
+	case 3:
+		writer.SetCompression(proio.LZMA)
+	case 2:
+		writer.SetCompression(proio.GZIP)
+	case 1:
+		writer.SetCompression(proio.LZ4)
+	default:
+		writer.SetCompression(proio.UNCOMPRESSED)
+	}
+	if *bucketSize > 0 {
+		writer.BucketDumpThres = *bucketSize
+	}
+	writer.SetConcurrency(*nWorkers)
+
+	var lastValue []byte
+	nEventsRead := 0
+
+	for {
+		var event *proio.Event
+		for event = range reader.ScanEvents(10) {
+			if *metadataKey != "" {
+				value := event.Metadata[*metadataKey]
+				if nEventsRead > 0 && !bytes.Equal(value, lastValue) {
+					if err := writer.Rotate(); err != nil {
+						log.Fatal(err)
+					}
+				}
+				lastValue = value
+			}
+
+			if err := writer.Push(event); err != nil {
+				log.Fatal(err)
+			}
+			nEventsRead++
+		}
+
+		if reader.Err == io.EOF || reader.Err == nil {
+			break
+		} else {
+			log.Print(reader.Err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -15,21 +15,30 @@ var (
 	outFile       = flag.String("o", "", "file to save output to")
 	intersection  = flag.Bool("i", false, "only strip the intersection of the specified tags (entries that each have all tags)")
 	keep          = flag.Bool("k", false, "keep only entries with the specified tags, rather than stripping them away")
+	selection     = flag.String("s", "", "strip entries selected by this expression (e.g. \"Particle.pdg == 11 && Truth\") instead of by tags")
 	stripMetadata = flag.Bool("m", false, "strip all metadata")
-	compLevel     = flag.Int("c", 2, "output compression level: 0 for uncompressed, 1 for LZ4 compression, 2 for GZIP compression, 3 for LZMA compression")
+	compact       = flag.Bool("C", false, "renumber the remaining entries densely, removing references to stripped entries")
+	compLevel     = flag.Int("c", 2, "output compression level: 0 for uncompressed, 1 for LZ4 compression, 2 for GZIP compression, 3 for LZMA compression, 4 for ZSTD compression")
 	readBufSize   = flag.Int("b", 10, "read buffer size in number of events")
 	maxEvents     = flag.Int("n", 0, "maximum number of events to read in")
 )
 
 func printUsage() {
 	fmt.Fprintf(os.Stderr,
-		`Usage: proio-strip [options] <proio-input-file> [tags...]
+		`Usage: proio-strip [options] <proio-input-file-or-glob> [tags...]
 
 proio-strip will take an input proio file and either strip away entries with
 specific tags, or keep only entries with specific tags.  It can also be used to
 simply re-encode the proio stream by omitting tags.  By default, the output
 stream is pushed to stdout, but the -o option can be used to create a file at a
-specified path.
+specified path.  The input may be a quoted glob pattern, in which case all
+matching files are read in lexical order as one continuous stream.  Instead of
+tags, a selection expression can be given with the -s option, which combines
+tag names and comparisons of entry fields with boolean logic (e.g.
+"Particle.pdg == 11 && !Truth"), and -k then keeps only the selected entries.
+The -C option renumbers the remaining entries and rewrites their references
+(e.g. particle parents and children) accordingly, dropping references to
+entries that were stripped.
 
 options:
 `,
@@ -41,11 +50,19 @@ func main() {
 	flag.Usage = printUsage
 	flag.Parse()
 
-	if flag.NArg() < 1 {
+	if flag.NArg() < 1 || *selection != "" && flag.NArg() > 1 {
 		printUsage()
 		log.Fatal("Invalid arguments")
 	}
 
+	var sel *proio.Selection
+	if *selection != "" {
+		var err error
+		if sel, err = proio.ParseSelection(*selection); err != nil {
+			log.Fatal(err)
+		}
+	}
+
 	var reader *proio.Reader
 	var err error
 
@@ -54,7 +71,7 @@ func main() {
 		stdin := bufio.NewReader(os.Stdin)
 		reader = proio.NewReader(stdin)
 	} else {
-		reader, err = proio.Open(filename)
+		reader, err = proio.OpenGlob(filename)
 	}
 	if err != nil {
 		log.Fatal(err)
@@ -71,6 +88,8 @@ func main() {
 		}
 	}
 	switch *compLevel {
+	case 4:
+		writer.SetCompression(proio.ZSTD)
 	case 3:
 		writer.SetCompression(proio.LZMA)
 	case 2:
@@ -96,27 +115,33 @@ func main() {
 				event.Metadata = nil
 			}
 
-			if *keep {
-				keepTagIDs := make(map[uint64]bool)
-				for _, keepTag := range argTags {
-					for _, entryID := range event.TaggedEntries(keepTag) {
-						keepTagIDs[entryID] = true
-					}
+			if sel != nil {
+				selectedIDs, err := sel.SelectEntries(event)
+				if err != nil {
+					log.Fatal(err)
+				}
+				selected := make(map[uint64]bool)
+				for _, entryID := range selectedIDs {
+					selected[entryID] = true
 				}
 				for _, entryID := range event.AllEntries() {
-					if !keepTagIDs[entryID] {
+					if selected[entryID] != *keep {
 						event.RemoveEntry(entryID)
 					}
 				}
 			} else {
-				removeTagIDs := make(map[uint64]int)
-				for _, removeTag := range argTags {
-					for _, entryID := range event.TaggedEntries(removeTag) {
-						removeTagIDs[entryID]++
-					}
+				var taggedIDs []uint64
+				if *intersection && !*keep {
+					taggedIDs = event.TaggedEntriesIntersection(argTags...)
+				} else {
+					taggedIDs = event.TaggedEntriesUnion(argTags...)
+				}
+				tagged := make(map[uint64]bool)
+				for _, entryID := range taggedIDs {
+					tagged[entryID] = true
 				}
-				for entryID, count := range removeTagIDs {
-					if !*intersection || count == len(argTags) {
+				for _, entryID := range event.AllEntries() {
+					if tagged[entryID] != *keep {
 						event.RemoveEntry(entryID)
 					}
 				}
@@ -128,6 +153,12 @@ func main() {
 				}
 			}
 
+			if *compact {
+				if _, err := event.Compact(proio.NullDangling); err != nil {
+					log.Fatal(err)
+				}
+			}
+
 			if err := writer.Push(event); err != nil {
 				log.Fatal(err)
 			}
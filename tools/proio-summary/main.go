@@ -7,6 +7,7 @@ import (
 	"io"
 	"log"
 	"os"
+	"path/filepath"
 
 	protobuf "github.com/golang/protobuf/proto"
 	"github.com/proio-org/go-proio"
@@ -19,42 +20,76 @@ var (
 
 func printUsage() {
 	fmt.Fprintf(os.Stderr,
-		`Usage: proio-summary [options] <proio-input-file>
+		`Usage: proio-summary [options] <proio-input-files-or-globs...>
+
+proio-summary prints a summary of the contents of proio streams.  If several
+inputs are given, the totals over all of them are printed.
+
 options:
 `,
 	)
 	flag.PrintDefaults()
 }
 
+var (
+	nBuckets = make(map[proto.BucketHeader_CompType]int)
+	nEvents  = 0
+	nFDs     = 0
+)
+
 func main() {
 	flag.Usage = printUsage
 	flag.Parse()
 
-	if flag.NArg() != 1 {
+	if flag.NArg() < 1 {
 		printUsage()
 		log.Fatal("Invalid arguments")
 	}
 
-	var reader *proio.Reader
-	var err error
+	for _, arg := range flag.Args() {
+		if arg == "-" {
+			reader := proio.NewReader(bufio.NewReader(os.Stdin))
+			summarizeStream(reader)
+			reader.Close()
+			continue
+		}
 
-	filename := flag.Arg(0)
-	if filename == "-" {
-		stdin := bufio.NewReader(os.Stdin)
-		reader = proio.NewReader(stdin)
-	} else {
-		reader, err = proio.Open(filename)
-	}
-	if err != nil {
-		log.Fatal(err)
+		filenames, err := filepath.Glob(arg)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(filenames) == 0 {
+			filenames = []string{arg}
+		}
+		for _, filename := range filenames {
+			reader, err := proio.Open(filename)
+			if err != nil {
+				log.Fatal(err)
+			}
+			summarizeFile(reader)
+			reader.Close()
+		}
 	}
-	defer reader.Close()
 
-	nBuckets := make(map[proto.BucketHeader_CompType]int)
-	nEvents := 0
-	nFDs := 0
+	fmt.Println("Number of ZSTD buckets:", nBuckets[proto.BucketHeader_ZSTD])
+	fmt.Println("Number of LZMA buckets:", nBuckets[proto.BucketHeader_LZMA])
+	fmt.Println("Number of LZ4 buckets:", nBuckets[proto.BucketHeader_LZ4])
+	fmt.Println("Number of GZIP buckets:", nBuckets[proto.BucketHeader_GZIP])
+	fmt.Println("Number of uncompressed buckets:", nBuckets[proto.BucketHeader_NONE])
+	fmt.Println("Number of events:", nEvents)
+	fmt.Println("Number of FileDescriptorProtos:", nFDs)
 
-	reader.Skip(0)
+	if *printFileDescriptors {
+		fmt.Println()
+		protos := proio.StoredFileDescriptorProtos()
+		for _, proto := range protos {
+			fmt.Println(protobuf.MarshalTextString(proto))
+		}
+	}
+}
+
+func summarizeStream(reader *proio.Reader) {
+	_, err := reader.Skip(0)
 	for reader.BucketHeader != nil {
 		header := reader.BucketHeader
 
@@ -66,25 +101,30 @@ func main() {
 		nEvents += int(header.NEvents)
 		nFDs += len(header.FileDescriptor)
 
-		reader.Skip(header.NEvents)
+		_, err = reader.Skip(header.NEvents)
 	}
 
 	if err != nil && err != io.EOF {
 		log.Print(err)
 	}
+}
 
-	fmt.Println("Number of LZMA buckets:", nBuckets[proto.BucketHeader_LZMA])
-	fmt.Println("Number of LZ4 buckets:", nBuckets[proto.BucketHeader_LZ4])
-	fmt.Println("Number of GZIP buckets:", nBuckets[proto.BucketHeader_GZIP])
-	fmt.Println("Number of uncompressed buckets:", nBuckets[proto.BucketHeader_NONE])
-	fmt.Println("Number of events:", nEvents)
-	fmt.Println("Number of FileDescriptorProtos:", nFDs)
+func summarizeFile(reader *proio.Reader) {
+	index, err := reader.Index()
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	if *printFileDescriptors {
-		fmt.Println()
-		protos := proio.StoredFileDescriptorProtos()
-		for _, proto := range protos {
-			fmt.Println(protobuf.MarshalTextString(proto))
+	for _, bucket := range index.Buckets {
+		nBuckets[bucket.Compression]++
+		if bucket.FileDescriptors {
+			header, err := reader.BucketHeaderAt(bucket)
+			if err != nil {
+				log.Print(err)
+				continue
+			}
+			nFDs += len(header.FileDescriptor)
 		}
 	}
+	nEvents += int(index.NEvents())
 }
@@ -0,0 +1,267 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/proio-org/go-proio"
+)
+
+var (
+	reportFile = flag.String("j", "", "file to save a JSON report to (\"-\" for stdout, in which case the summary goes to stderr)")
+	maxListed  = flag.Int("n", 20, "maximum number of problems to list per input in the summary (0 for no limit)")
+	quiet      = flag.Bool("q", false, "do not print the summary")
+)
+
+func printUsage() {
+	fmt.Fprintf(os.Stderr,
+		`Usage: proio-validate [options] <proio-input-files-or-globs...>
+
+proio-validate checks the integrity of proio streams, and reports:
+  resync               bytes that were skipped to resynchronize the stream
+  truncated            buckets that end before all of their events
+  checksum             buckets whose bytes do not match their checksum
+  undecodable          events or entries that cannot be decoded
+  unknown-type         entries of types without a FileDescriptorProto
+  dangling-tag         tags that refer to missing entries
+  dangling-reference   reference fields that refer to missing entries
+Each input is validated on its own.  A summary is printed, and the -j option
+can be used to save a machine-readable report.  proio-validate exits with
+status 0 if no problems are found, 1 if there are problems, and 2 if an input
+cannot be validated at all.
+
+options:
+`,
+	)
+	flag.PrintDefaults()
+}
+
+type problem struct {
+	Kind   string `json:"kind"`
+	Offset int64  `json:"offset"`
+	Event  int64  `json:"event"`
+	Entry  uint64 `json:"entry,omitempty"`
+	Detail string `json:"detail"`
+}
+
+type inputReport struct {
+	Input    string    `json:"input"`
+	Buckets  int       `json:"buckets"`
+	Events   int64     `json:"events"`
+	Problems []problem `json:"problems"`
+	Valid    bool      `json:"valid"`
+}
+
+func main() {
+	flag.Usage = printUsage
+	flag.Parse()
+
+	if flag.NArg() < 1 {
+		printUsage()
+		fail("Invalid arguments")
+	}
+
+	var reports []*inputReport
+	for _, arg := range flag.Args() {
+		if arg == "-" {
+			reader := proio.NewReader(bufio.NewReader(os.Stdin))
+			reports = append(reports, validate(reader, "-"))
+			reader.Close()
+			continue
+		}
+
+		filenames, err := filepath.Glob(arg)
+		if err != nil {
+			fail(err)
+		}
+		if len(filenames) == 0 {
+			filenames = []string{arg}
+		}
+		for _, filename := range filenames {
+			reader, err := proio.Open(filename)
+			if err != nil {
+				fail(err)
+			}
+			reports = append(reports, validate(reader, filename))
+			reader.Close()
+		}
+	}
+
+	summaryOut := os.Stdout
+	if *reportFile != "" {
+		reportBytes, err := json.MarshalIndent(reports, "", "  ")
+		if err != nil {
+			fail(err)
+		}
+		reportBytes = append(reportBytes, '\n')
+		if *reportFile == "-" {
+			summaryOut = os.Stderr
+			_, err = os.Stdout.Write(reportBytes)
+		} else {
+			err = ioutil.WriteFile(*reportFile, reportBytes, 0644)
+		}
+		if err != nil {
+			fail(err)
+		}
+	}
+
+	valid := true
+	for _, report := range reports {
+		if !*quiet {
+			printSummary(summaryOut, report)
+		}
+		valid = valid && report.Valid
+	}
+	if !valid {
+		os.Exit(1)
+	}
+}
+
+// validate reads through a stream, collecting its problems.
+func validate(reader *proio.Reader, input string) *inputReport {
+	report := &inputReport{Input: input, Problems: []problem{}}
+	addProblem := func(kind string, event int64, entry uint64, detail string) {
+		report.Problems = append(report.Problems, problem{kind, reader.BucketOffset(), event, entry, detail})
+	}
+
+	bucketOffset := int64(-1)
+	nEvent := int64(0)
+
+	reader.SetDamagePolicy(proio.SkipDamage)
+	reader.SetDamageHandler(func(damage *proio.DamageReport) {
+		kind := "undecodable"
+		if _, ok := damage.Err.(*proio.ChecksumError); ok {
+			kind = "checksum"
+		} else if damage.Err == io.ErrUnexpectedEOF {
+			kind = "truncated"
+		} else if damage.Err == proio.ErrResynchronized {
+			kind = "resync"
+		}
+
+		prob := problem{kind, damage.Offset, -1, 0, fmt.Sprintf("%v bytes skipped", damage.BytesSkipped)}
+		if damage.EventsLost >= 0 {
+			// the damage is within a bucket that has an intact header
+			if damage.Offset != bucketOffset {
+				bucketOffset = damage.Offset
+				report.Buckets++
+			}
+			prob.Event = nEvent
+			prob.Detail += fmt.Sprintf(", %v events lost", damage.EventsLost)
+			nEvent += damage.EventsLost
+		}
+		prob.Detail += ": " + damage.Err.Error()
+		report.Problems = append(report.Problems, prob)
+	})
+
+	for {
+		event := reader.Next()
+
+		if reader.BucketHeader != nil && reader.BucketOffset() != bucketOffset {
+			bucketOffset = reader.BucketOffset()
+			report.Buckets++
+		}
+
+		if event == nil {
+			if reader.Err != io.EOF && reader.Err != nil {
+				addProblem("undecodable", nEvent, 0, reader.Err.Error())
+			}
+			break
+		}
+
+		checkEvent(event, nEvent, addProblem)
+		nEvent++
+	}
+
+	report.Events = nEvent
+	report.Valid = len(report.Problems) == 0
+	return report
+}
+
+func checkEvent(event *proio.Event, nEvent int64, addProblem func(string, int64, uint64, string)) {
+	entries := make(map[uint64]bool)
+	for _, id := range event.AllEntries() {
+		entries[id] = true
+	}
+
+	for _, tag := range event.Tags() {
+		for _, id := range event.TaggedEntries(tag) {
+			if !entries[id] {
+				addProblem("dangling-tag", nEvent, id, "tag "+tag+" refers to missing entry")
+			}
+		}
+	}
+
+	ids := event.AllEntries()
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		if event.GetDynamicEntry(id) == nil {
+			kind := "undecodable"
+			if _, ok := event.Err.(*proio.UnknownTypeError); ok {
+				kind = "unknown-type"
+			}
+			addProblem(kind, nEvent, id, event.Err.Error())
+			continue
+		}
+
+		refs, err := event.EntryReferences(id)
+		if err != nil {
+			addProblem("undecodable", nEvent, id, err.Error())
+			continue
+		}
+		for _, ref := range refs {
+			if !entries[ref.To] {
+				addProblem("dangling-reference", nEvent, id, fmt.Sprintf("field %v refers to missing entry %v", ref.Field, ref.To))
+			}
+		}
+	}
+}
+
+func printSummary(out io.Writer, report *inputReport) {
+	status := "OK"
+	if !report.Valid {
+		status = "FAILED"
+	}
+	fmt.Fprintf(out, "%v: %v (%v buckets, %v events, %v problems)\n",
+		report.Input, status, report.Buckets, report.Events, len(report.Problems))
+
+	counts := make(map[string]int)
+	for _, prob := range report.Problems {
+		counts[prob.Kind]++
+	}
+	var kinds []string
+	for kind := range counts {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		fmt.Fprintf(out, "  %v: %v\n", kind, counts[kind])
+	}
+
+	for i, prob := range report.Problems {
+		if *maxListed > 0 && i == *maxListed {
+			fmt.Fprintf(out, "  ... %v more\n", len(report.Problems)-i)
+			break
+		}
+		location := fmt.Sprintf("offset %v", prob.Offset)
+		if prob.Event >= 0 {
+			location += fmt.Sprintf(", event %v", prob.Event)
+		}
+		if prob.Entry > 0 {
+			location += fmt.Sprintf(", entry %v", prob.Entry)
+		}
+		fmt.Fprintf(out, "  %v (%v): %v\n", prob.Kind, location, prob.Detail)
+	}
+}
+
+func fail(v ...interface{}) {
+	log.Print(v...)
+	os.Exit(2)
+}
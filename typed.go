@@ -0,0 +1,73 @@
+package proio
+
+import (
+	"errors"
+	"reflect"
+
+	protobuf "github.com/golang/protobuf/proto"
+)
+
+// EntriesOfType returns the IDs of all entries in the Event whose stored
+// message type has the given fully-qualified name (e.g.
+// "proio.model.lcio.MCParticle"), in increasing order.  Unlike tags, this does
+// not depend on the conventions of whatever wrote the Event.
+func (evt *Event) EntriesOfType(typeName string) []uint64 {
+	var entries []uint64
+	for id, entryProto := range evt.proto.Entry {
+		if evt.proto.Type[entryProto.Type] == typeName {
+			entries = append(entries, id)
+		}
+	}
+	return sortEntries(entries)
+}
+
+// EntriesOfMessageType is like EntriesOfType, except that the type is given by
+// a message of that type, e.g. &lcio.MCParticle{}.
+func (evt *Event) EntriesOfMessageType(msg protobuf.Message) []uint64 {
+	return evt.EntriesOfType(protobuf.MessageName(msg))
+}
+
+// GetEntriesOfType deserializes all entries of a type, and appends them to the
+// slice pointed to by entries.  The type is determined by the slice, which
+// must be a slice of pointers to a generated message type, e.g.
+//
+//	var mcParts []*lcio.MCParticle
+//	ids, err := event.GetEntriesOfType(&mcParts)
+//
+// The IDs of the appended entries are returned in the same order, which is
+// increasing.
+func (evt *Event) GetEntriesOfType(entries interface{}) ([]uint64, error) {
+	slicePtr := reflect.ValueOf(entries)
+	if slicePtr.Kind() != reflect.Ptr || slicePtr.Elem().Kind() != reflect.Slice {
+		return nil, errors.New("entries must be a pointer to a slice")
+	}
+	slice := slicePtr.Elem()
+	elemType := slice.Type().Elem()
+	if elemType.Kind() != reflect.Ptr {
+		return nil, errors.New("entries must be a pointer to a slice of message pointers")
+	}
+	msg, ok := reflect.New(elemType.Elem()).Interface().(protobuf.Message)
+	if !ok {
+		return nil, errors.New("entries must be a pointer to a slice of message pointers")
+	}
+	typeName := protobuf.MessageName(msg)
+	if typeName == "" {
+		return nil, errors.New("message type of entries is not registered")
+	}
+
+	ids := evt.EntriesOfType(typeName)
+	for _, id := range ids {
+		entry := evt.GetEntry(id)
+		if entry == nil {
+			return nil, evt.Err
+		}
+		entryValue := reflect.ValueOf(entry)
+		if entryValue.Type() != elemType {
+			return nil, errors.New("entry type " + typeName + " is not linked as " + elemType.String())
+		}
+		slice = reflect.Append(slice, entryValue)
+	}
+	slicePtr.Elem().Set(slice)
+
+	return ids, nil
+}
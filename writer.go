@@ -7,9 +7,12 @@ import (
 	"errors"
 	"io"
 	"os"
+	"sort"
 	"sync"
+	"time"
 
 	protobuf "github.com/golang/protobuf/proto"
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4"
 	proto "github.com/proio-org/go-proio-pb"
 	"github.com/smira/lzma"
@@ -22,20 +25,55 @@ const (
 	GZIP
 	LZ4
 	LZMA
+	ZSTD
 )
 
 // Writer serves to write Events into a stream in the proio format.  The Writer
 // is not inherently thread safe, but it conveniently embeds sync.Mutex so that
 // it can be locked and unlocked.
+//
+// If WriteIndex is set, Close appends an Index of the buckets to the end of
+// the stream, which Readers of seekable streams use to avoid scanning all of
+// the bucket headers.  The Index is not framed by the magic number, so readers
+// that are unaware of it skip over it.
+//
+// If WriteChecksums is set, a CRC32C checksum of the bytes of each bucket (as
+// they appear in the stream, after compression) is recorded in the bucket
+// header, which Readers verify (see ChecksumError).
+//
+// A bucket is written to the stream once its uncompressed size exceeds
+// BucketDumpThres bytes.  If BucketMaxEvents is nonzero, a bucket is also
+// written once it holds that many events, and if BucketMaxAge is nonzero, a
+// bucket is written at the latest when its first event has waited for that
+// long, so that consumers of slow streams receive events in good time.  The
+// latter is done from a timer goroutine, which the Writer synchronizes with
+// its own methods, so that no locking is needed of the caller.  The exported
+// fields should not be changed while events are being pushed.  An error from
+// a flush by the timer is returned by the next call to Push or Flush.
 type Writer struct {
 	BucketDumpThres int
+	BucketMaxEvents uint64
+	BucketMaxAge    time.Duration
 	CompLevel       int
+	WriteIndex      bool
+	WriteChecksums  bool
 
 	streamWriter io.Writer
+	streamOffset int64
+	index        Index
+	compressor   *compressor
+	rotation     *rotation
+	atomic       *atomicOutput
 	bucket       *bytes.Buffer
 	bucketHeader proto.BucketHeader
 	metadata     map[string][]byte
 	writtenFDs   map[protobuf.Message]bool
+	ageTimer     *time.Timer
+	bucketGen    uint64
+	ageErr       error
+
+	// bucketMutex guards the state of the Writer against the age timer
+	bucketMutex sync.Mutex
 
 	deferredUntilClose []func() error
 
@@ -57,8 +95,32 @@ func Create(filename string) (*Writer, error) {
 	return writer, nil
 }
 
-// Flush flushes any of the Writer's bucket contents.
+// Flush flushes any of the Writer's bucket contents.  If buckets are being
+// compressed concurrently, Flush waits until all of them have been written to
+// the stream.
 func (wrt *Writer) Flush() error {
+	wrt.bucketMutex.Lock()
+	defer wrt.bucketMutex.Unlock()
+
+	return wrt.flush()
+}
+
+func (wrt *Writer) flush() error {
+	if err := wrt.takeAgeErr(); err != nil {
+		return err
+	}
+	if err := wrt.flushBucket(); err != nil {
+		return err
+	}
+	if wrt.compressor != nil {
+		return wrt.compressor.wait()
+	}
+	return nil
+}
+
+// flushBucket writes out the current bucket, if it has any contents, without
+// waiting for concurrent compression to finish.
+func (wrt *Writer) flushBucket() error {
 	if wrt.bucket.Len() > 0 {
 		err := wrt.writeBucket()
 		if err != nil {
@@ -68,14 +130,66 @@ func (wrt *Writer) Flush() error {
 	return nil
 }
 
+// startAgeTimer starts the timer that flushes the current bucket once it
+// reaches BucketMaxAge.  The timer only flushes the bucket it was started
+// for, since writeBucket moves on to the next generation of buckets.
+func (wrt *Writer) startAgeTimer() {
+	gen := wrt.bucketGen
+	wrt.ageTimer = time.AfterFunc(wrt.BucketMaxAge, func() {
+		wrt.bucketMutex.Lock()
+		defer wrt.bucketMutex.Unlock()
+
+		if gen != wrt.bucketGen || wrt.bucket.Len() == 0 {
+			return
+		}
+		if err := wrt.flush(); err != nil && wrt.ageErr == nil {
+			wrt.ageErr = err
+		}
+	})
+}
+
+// stopAgeTimer stops the age timer of the current bucket, if any.  A timer
+// that has already fired does not flush, since the bucket generation moves
+// on.
+func (wrt *Writer) stopAgeTimer() {
+	wrt.bucketGen++
+	if wrt.ageTimer != nil {
+		wrt.ageTimer.Stop()
+		wrt.ageTimer = nil
+	}
+}
+
+// takeAgeErr returns and clears any error from a flush by the age timer.
+func (wrt *Writer) takeAgeErr() error {
+	err := wrt.ageErr
+	wrt.ageErr = nil
+	return err
+}
+
 // Close calls Flush and closes any file that was created by the library.
-// Close does not close io.Writers passed directly to NewWriter.
+// Close does not close io.Writers passed directly to NewWriter.  For a Writer
+// created with CreateAtomic, Close also moves the file into place, or removes
+// it if anything failed.
 func (wrt *Writer) Close() error {
+	wrt.bucketMutex.Lock()
+	wrt.stopAgeTimer()
+	wrt.bucketMutex.Unlock()
+	defer wrt.stopCompressor()
+
+	atomic := wrt.atomic
+	wrt.atomic = nil
+
 	for _, thisFunc := range wrt.deferredUntilClose {
 		if err := thisFunc(); err != nil {
+			if atomic != nil {
+				atomic.discard()
+			}
 			return err
 		}
 	}
+	if atomic != nil {
+		return atomic.commit()
+	}
 	return nil
 }
 
@@ -91,8 +205,13 @@ func NewWriter(streamWriter io.Writer) *Writer {
 		writtenFDs:      make(map[protobuf.Message]bool),
 	}
 
+	if seeker, ok := streamWriter.(io.Seeker); ok {
+		writer.streamOffset, _ = seeker.Seek(0, 1 /*io.SeekCurrent*/)
+	}
+
 	writer.SetCompression(GZIP)
 	writer.DeferUntilClose(writer.Flush)
+	writer.DeferUntilClose(writer.writeIndexFooter)
 
 	return writer
 }
@@ -100,6 +219,9 @@ func NewWriter(streamWriter io.Writer) *Writer {
 // Set compression type, for example to GZIP or UNCOMPRESSED.  This can be
 // called even after writing some events.
 func (wrt *Writer) SetCompression(comp Compression) error {
+	wrt.bucketMutex.Lock()
+	defer wrt.bucketMutex.Unlock()
+
 	switch comp {
 	case LZMA:
 		wrt.bucketHeader.Compression = proto.BucketHeader_LZMA
@@ -107,6 +229,8 @@ func (wrt *Writer) SetCompression(comp Compression) error {
 		wrt.bucketHeader.Compression = proto.BucketHeader_GZIP
 	case LZ4:
 		wrt.bucketHeader.Compression = proto.BucketHeader_LZ4
+	case ZSTD:
+		wrt.bucketHeader.Compression = proto.BucketHeader_ZSTD
 	case UNCOMPRESSED:
 		wrt.bucketHeader.Compression = proto.BucketHeader_NONE
 	default:
@@ -123,15 +247,22 @@ type getDependencyer interface {
 // Serialize the given Event.  Once this is performed, changes to the Event in
 // memory are not reflected in the output stream.
 func (wrt *Writer) Push(event *Event) error {
+	wrt.bucketMutex.Lock()
+	defer wrt.bucketMutex.Unlock()
+
+	if err := wrt.takeAgeErr(); err != nil {
+		return err
+	}
+
 	for key, value := range event.Metadata {
 		if !bytes.Equal(wrt.metadata[key], value) {
-			wrt.PushMetadata(key, value)
+			wrt.pushMetadata(key, value)
 			wrt.metadata[key] = value
 		}
 	}
 
 	event.FlushCache()
-	protoBuf, err := event.proto.Marshal()
+	protoBuf, err := marshalEvent(event.proto)
 	if err != nil {
 		return err
 	}
@@ -164,7 +295,9 @@ func (wrt *Writer) Push(event *Event) error {
 		}
 	}
 	if len(newFDs) > 0 {
-		wrt.Flush()
+		if err := wrt.flushBucket(); err != nil {
+			return err
+		}
 	}
 	for fdProto := range newFDs {
 		fdBytes, err := protobuf.Marshal(fdProto)
@@ -178,18 +311,29 @@ func (wrt *Writer) Push(event *Event) error {
 	writeBytes(wrt.bucket, protoBuf)
 
 	wrt.bucketHeader.NEvents++
+	if wrt.bucketHeader.NEvents == 1 && wrt.BucketMaxAge > 0 {
+		wrt.startAgeTimer()
+	}
 
-	if wrt.bucket.Len() > wrt.BucketDumpThres {
+	if wrt.bucket.Len() > wrt.BucketDumpThres ||
+		wrt.BucketMaxEvents > 0 && wrt.bucketHeader.NEvents >= wrt.BucketMaxEvents {
 		if err := wrt.writeBucket(); err != nil {
 			return err
 		}
 	}
 
-	return nil
+	return wrt.rotatePush()
 }
 
 func (wrt *Writer) PushMetadata(name string, data []byte) error {
-	if err := wrt.Flush(); err != nil {
+	wrt.bucketMutex.Lock()
+	defer wrt.bucketMutex.Unlock()
+
+	return wrt.pushMetadata(name, data)
+}
+
+func (wrt *Writer) pushMetadata(name string, data []byte) error {
+	if err := wrt.flushBucket(); err != nil {
 		return err
 	}
 	if wrt.bucketHeader.Metadata == nil {
@@ -219,45 +363,131 @@ var magicBytes = [...]byte{
 }
 
 func (wrt *Writer) writeBucket() (err error) {
-	bucketBytes := wrt.bucket.Bytes()
-	switch wrt.bucketHeader.Compression {
+	header := wrt.bucketHeader
+
+	if wrt.compressor != nil {
+		// hand the bucket over to the compression pipeline
+		bucketBytes := wrt.bucket.Bytes()
+		wrt.bucket = &bytes.Buffer{}
+		err = wrt.compressor.submit(header, wrt.CompLevel, bucketBytes)
+	} else {
+		var bucketBytes []byte
+		bucketBytes, err = compressBucket(header.Compression, wrt.CompLevel, wrt.bucket.Bytes())
+		if err == nil {
+			err = wrt.writeFrame(&header, bucketBytes)
+		}
+		wrt.bucket.Reset()
+	}
+	if err != nil {
+		return
+	}
+
+	wrt.bucketHeader.NEvents = 0
+	wrt.bucketHeader.Metadata = make(map[string][]byte)
+	wrt.bucketHeader.FileDescriptor = nil
+
+	wrt.stopAgeTimer()
+
+	return nil
+}
+
+// compressBucket compresses the bytes of a bucket according to comp and
+// level.  A negative level selects the default level for the algorithm.
+func compressBucket(comp proto.BucketHeader_CompType, level int, bucketBytes []byte) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	switch comp {
 	case proto.BucketHeader_GZIP:
-		buffer := &bytes.Buffer{}
 		var gzipWriter *gzip.Writer
-		if wrt.CompLevel >= 0 {
-			if gzipWriter, err = gzip.NewWriterLevel(buffer, wrt.CompLevel); err != nil {
-				return
+		if level >= 0 {
+			var err error
+			if gzipWriter, err = gzip.NewWriterLevel(buffer, level); err != nil {
+				return nil, err
 			}
 		} else {
 			gzipWriter = gzip.NewWriter(buffer)
 		}
 		gzipWriter.Write(bucketBytes)
-		gzipWriter.Close()
-		bucketBytes = buffer.Bytes()
+		if err := gzipWriter.Close(); err != nil {
+			return nil, err
+		}
 	case proto.BucketHeader_LZ4:
-		buffer := &bytes.Buffer{}
 		lz4Writer := lz4.NewWriter(buffer)
-		if wrt.CompLevel >= 0 {
-			lz4Writer.Header.CompressionLevel = wrt.CompLevel
+		if level >= 0 {
+			lz4Writer.Header.CompressionLevel = level
 		}
 		lz4Writer.Write(bucketBytes)
-		lz4Writer.Close()
-		bucketBytes = buffer.Bytes()
+		if err := lz4Writer.Close(); err != nil {
+			return nil, err
+		}
 	case proto.BucketHeader_LZMA:
-		buffer := &bytes.Buffer{}
+		// the LZMA encoder initializes package-level tables for each
+		// writer, so only one bucket can be compressed at a time
+		lzmaMutex.Lock()
+		defer lzmaMutex.Unlock()
+
 		var lzmaWriter io.WriteCloser
-		if wrt.CompLevel >= 0 {
-			lzmaWriter = lzma.NewWriterLevel(buffer, wrt.CompLevel)
+		if level >= 0 {
+			lzmaWriter = lzma.NewWriterLevel(buffer, level)
 		} else {
 			lzmaWriter = lzma.NewWriter(buffer)
 		}
 		lzmaWriter.Write(bucketBytes)
-		lzmaWriter.Close()
-		bucketBytes = buffer.Bytes()
+		if err := lzmaWriter.Close(); err != nil {
+			return nil, err
+		}
+	case proto.BucketHeader_ZSTD:
+		encoderLevel := zstd.SpeedDefault
+		if level >= 0 {
+			encoderLevel = zstd.EncoderLevelFromZstd(level)
+		}
+		zstdEncoder, err := sharedZstdEncoder(encoderLevel)
+		if err != nil {
+			return nil, err
+		}
+		return zstdEncoder.EncodeAll(bucketBytes, nil), nil
+	default:
+		return bucketBytes, nil
 	}
-	header := wrt.bucketHeader
+	return buffer.Bytes(), nil
+}
+
+var lzmaMutex sync.Mutex
+
+var (
+	zstdEncoders     = make(map[zstd.EncoderLevel]*zstd.Encoder)
+	zstdEncoderMutex sync.Mutex
+)
+
+// sharedZstdEncoder returns a Zstandard encoder for the given level that is
+// shared by all Writers and compressor workers, since EncodeAll is safe for
+// concurrent use.  Like the shared decoder, each encoder is created on first
+// use and kept, so that its goroutines and buffers are not leaked per bucket.
+func sharedZstdEncoder(level zstd.EncoderLevel) (*zstd.Encoder, error) {
+	zstdEncoderMutex.Lock()
+	defer zstdEncoderMutex.Unlock()
+	if encoder, ok := zstdEncoders[level]; ok {
+		return encoder, nil
+	}
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(level))
+	if err != nil {
+		return nil, err
+	}
+	zstdEncoders[level] = encoder
+	return encoder, nil
+}
+
+// writeFrame writes a bucket header and the already compressed bucket bytes
+// to the stream, framed by the magic number.
+func (wrt *Writer) writeFrame(header *proto.BucketHeader, bucketBytes []byte) error {
 	header.BucketSize = uint64(len(bucketBytes))
-	headerBuf, err := (&header).Marshal()
+	if wrt.WriteChecksums {
+		setBucketChecksum(header, bucketBytes)
+	}
+	header, err := wrt.rotateFrame(header, len(magicBytes)+4+header.Size()+len(bucketBytes))
+	if err != nil {
+		return err
+	}
+	headerBuf, err := marshalHeader(header)
 	if err != nil {
 		return err
 	}
@@ -275,14 +505,165 @@ func (wrt *Writer) writeBucket() (err error) {
 		return err
 	}
 
-	wrt.bucketHeader.NEvents = 0
-	wrt.bucketHeader.Metadata = make(map[string][]byte)
-	wrt.bucketHeader.FileDescriptor = nil
-	wrt.bucket.Reset()
+	wrt.index.Buckets = append(wrt.index.Buckets, BucketIndex{
+		Offset:          wrt.streamOffset,
+		FirstEvent:      wrt.index.NEvents(),
+		NEvents:         header.NEvents,
+		Compression:     header.Compression,
+		Metadata:        len(header.Metadata) > 0,
+		FileDescriptors: len(header.FileDescriptor) > 0,
+	})
+	wrt.streamOffset += int64(len(buf))
 
 	return nil
 }
 
+func (wrt *Writer) writeIndexFooter() error {
+	if !wrt.WriteIndex {
+		return nil
+	}
+
+	indexBuf, err := wrt.index.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	footerSizeBuf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(footerSizeBuf, uint32(len(indexBuf)))
+
+	buf := make([]byte, len(indexBuf)+len(footerSizeBuf)+len(indexMagicBytes))[:0]
+	buf = append(buf, indexBuf...)
+	buf = append(buf, footerSizeBuf...)
+	buf = append(buf, indexMagicBytes[:]...)
+
+	if err := writeBytes(wrt.streamWriter, buf); err != nil {
+		return err
+	}
+	wrt.streamOffset += int64(len(buf))
+
+	return nil
+}
+
+// marshalEvent marshals an event like its Marshal method does, but with the
+// entries of its maps in key order, so that equal events are always written
+// as equal bytes.  Each map entry is marshaled as part of an otherwise empty
+// event, since concatenated messages decode as one.
+func marshalEvent(event *proto.Event) ([]byte, error) {
+	tagNames := make([]string, 0, len(event.Tag))
+	for name := range event.Tag {
+		tagNames = append(tagNames, name)
+	}
+	sort.Strings(tagNames)
+	// entry IDs are numbered from 1 by the Event, so that they can usually
+	// be listed in order without sorting
+	entryIDs := make([]uint64, 0, len(event.Entry))
+	for id := uint64(1); id <= event.NEntries && len(entryIDs) < len(event.Entry); id++ {
+		if _, ok := event.Entry[id]; ok {
+			entryIDs = append(entryIDs, id)
+		}
+	}
+	if len(entryIDs) < len(event.Entry) {
+		entryIDs = entryIDs[:0]
+		for id := range event.Entry {
+			entryIDs = append(entryIDs, id)
+		}
+		sortEntries(entryIDs)
+	}
+	typeIDs := make([]uint64, 0, len(event.Type))
+	for id := range event.Type {
+		typeIDs = append(typeIDs, id)
+	}
+	sortEntries(typeIDs)
+
+	// part holds one field or map entry of the event at a time
+	buf := make([]byte, event.Size())
+	n := 0
+	part := &proto.Event{}
+	marshalPart := func() error {
+		partLen, err := part.MarshalTo(buf[n:])
+		n += partLen
+		*part = proto.Event{}
+		return err
+	}
+
+	for _, name := range tagNames {
+		part.Tag = map[string]*proto.Tag{name: event.Tag[name]}
+		if err := marshalPart(); err != nil {
+			return nil, err
+		}
+	}
+	part.NEntries = event.NEntries
+	if err := marshalPart(); err != nil {
+		return nil, err
+	}
+	// entries are by far the largest map, and are encoded directly
+	for _, id := range entryIDs {
+		entry := event.Entry[id]
+		entrySize := 1 + protobuf.SizeVarint(id)
+		if entry != nil {
+			entrySize += 1 + protobuf.SizeVarint(uint64(entry.Size())) + entry.Size()
+		}
+		buf[n] = 3<<3 | protobuf.WireBytes
+		n++
+		n += binary.PutUvarint(buf[n:], uint64(entrySize))
+		buf[n] = 1<<3 | protobuf.WireVarint
+		n++
+		n += binary.PutUvarint(buf[n:], id)
+		if entry != nil {
+			buf[n] = 2<<3 | protobuf.WireBytes
+			n++
+			n += binary.PutUvarint(buf[n:], uint64(entry.Size()))
+			entryLen, err := entry.MarshalTo(buf[n:])
+			if err != nil {
+				return nil, err
+			}
+			n += entryLen
+		}
+	}
+	part.NTypes = event.NTypes
+	if err := marshalPart(); err != nil {
+		return nil, err
+	}
+	for _, id := range typeIDs {
+		part.Type = map[uint64]string{id: event.Type[id]}
+		if err := marshalPart(); err != nil {
+			return nil, err
+		}
+	}
+	part.XXX_unrecognized = event.XXX_unrecognized
+	if err := marshalPart(); err != nil {
+		return nil, err
+	}
+
+	return buf[:n], nil
+}
+
+// marshalHeader marshals a bucket header with its metadata in key order, in
+// the same way as marshalEvent.
+func marshalHeader(header *proto.BucketHeader) ([]byte, error) {
+	keys := make([]string, 0, len(header.Metadata))
+	for key := range header.Metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	base := *header
+	base.Metadata = nil
+	buf, err := base.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		part := &proto.BucketHeader{Metadata: map[string][]byte{key: header.Metadata[key]}}
+		partBuf, err := part.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, partBuf...)
+	}
+	return buf, nil
+}
+
 func writeBytes(wrt io.Writer, buf []byte) error {
 	tot := 0
 	for tot < len(buf) {